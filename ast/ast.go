@@ -96,6 +96,16 @@ type StmtNode interface {
 	statement()
 }
 
+// ExecutableCommentNode is a statement recording the executable comment it is written in.
+// All the statements embedding stmtNode implement it.
+type ExecutableCommentNode interface {
+	StmtNode
+	// SetExecutableComment sets the executable comment the whole statement is written in.
+	SetExecutableComment(comment *ExecutableComment)
+	// ExecutableComment returns the executable comment the whole statement is written in, or nil.
+	ExecutableComment() *ExecutableComment
+}
+
 // DDLNode represents DDL statement node.
 type DDLNode interface {
 	StmtNode
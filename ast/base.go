@@ -35,11 +35,22 @@ func (n *node) Text() string {
 // Statement implementations should embed it in.
 type stmtNode struct {
 	node
+	executableComment *ExecutableComment
 }
 
 // statement implements StmtNode interface.
 func (sn *stmtNode) statement() {}
 
+// SetExecutableComment implements ExecutableCommentNode interface.
+func (sn *stmtNode) SetExecutableComment(comment *ExecutableComment) {
+	sn.executableComment = comment
+}
+
+// ExecutableComment implements ExecutableCommentNode interface.
+func (sn *stmtNode) ExecutableComment() *ExecutableComment {
+	return sn.executableComment
+}
+
 // ddlNode implements DDLNode interface.
 // DDL implementations should embed it in.
 type ddlNode struct {
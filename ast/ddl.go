@@ -14,6 +14,8 @@
 package ast
 
 import (
+	"strings"
+
 	"github.com/pingcap/errors"
 
 	"github.com/mia0x75/parser/auth"
@@ -25,22 +27,27 @@ import (
 var (
 	_ DDLNode = &AlterTableStmt{}
 	_ DDLNode = &AlterDatabaseStmt{}
+	_ DDLNode = &AlterServerStmt{}
 	_ DDLNode = &CreateDatabaseStmt{}
 	_ DDLNode = &CreateIndexStmt{}
+	_ DDLNode = &CreateServerStmt{}
 	_ DDLNode = &CreateTableStmt{}
 	_ DDLNode = &CreateViewStmt{}
 	_ DDLNode = &DropDatabaseStmt{}
 	_ DDLNode = &DropIndexStmt{}
+	_ DDLNode = &DropServerStmt{}
 	_ DDLNode = &DropTableStmt{}
 	_ DDLNode = &RenameTableStmt{}
 	_ DDLNode = &TruncateTableStmt{}
 
+	_ Node = &AlterOrderItem{}
 	_ Node = &AlterTableSpec{}
 	_ Node = &ColumnDef{}
 	_ Node = &ColumnOption{}
 	_ Node = &ColumnPosition{}
 	_ Node = &Constraint{}
 	_ Node = &IndexColName{}
+	_ Node = &LockAndAlgorithm{}
 	_ Node = &ReferenceDef{}
 )
 
@@ -58,6 +65,7 @@ const (
 	DatabaseOptionNone DatabaseOptionType = iota
 	DatabaseOptionCharset
 	DatabaseOptionCollate
+	DatabaseOptionComment
 )
 
 // DatabaseOption represents database option.
@@ -77,6 +85,10 @@ func (n *DatabaseOption) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("COLLATE")
 		ctx.WritePlain(" = ")
 		ctx.WritePlain(n.Value)
+	case DatabaseOptionComment:
+		ctx.WriteKeyWord("COMMENT")
+		ctx.WritePlain(" = ")
+		ctx.WriteString(n.Value)
 	default:
 		return errors.Errorf("invalid DatabaseOptionType: %d", n.Tp)
 	}
@@ -88,6 +100,7 @@ func (n *DatabaseOption) Restore(ctx *format.RestoreCtx) error {
 type CreateDatabaseStmt struct {
 	ddlNode
 
+	OrReplace   bool
 	IfNotExists bool
 	Name        string
 	Options     []*DatabaseOption
@@ -95,7 +108,11 @@ type CreateDatabaseStmt struct {
 
 // Restore implements Node interface.
 func (n *CreateDatabaseStmt) Restore(ctx *format.RestoreCtx) error {
-	ctx.WriteKeyWord("CREATE DATABASE ")
+	ctx.WriteKeyWord("CREATE ")
+	if n.OrReplace {
+		ctx.WriteKeyWord("OR REPLACE ")
+	}
+	ctx.WriteKeyWord("DATABASE ")
 	if n.IfNotExists {
 		ctx.WriteKeyWord("IF NOT EXISTS ")
 	}
@@ -128,15 +145,22 @@ type AlterDatabaseStmt struct {
 	Name                 string
 	AlterDefaultDatabase bool
 	Options              []*DatabaseOption
+	// UpgradeDataDirectoryName is set for `ALTER DATABASE db UPGRADE DATA DIRECTORY NAME`,
+	// which renames a database directory encoded in the pre-MySQL 5.1 format.
+	UpgradeDataDirectoryName bool
 }
 
 // Restore implements Node interface.
 func (n *AlterDatabaseStmt) Restore(ctx *format.RestoreCtx) error {
-	ctx.WriteKeyWord("ALTER DATABASE ")
+	ctx.WriteKeyWord("ALTER DATABASE")
 	if !n.AlterDefaultDatabase {
 		ctx.WritePlain(" ")
 		ctx.WriteName(n.Name)
 	}
+	if n.UpgradeDataDirectoryName {
+		ctx.WriteKeyWord(" UPGRADE DATA DIRECTORY NAME")
+		return nil
+	}
 	for i, option := range n.Options {
 		ctx.WritePlain(" ")
 		err := option.Restore(ctx)
@@ -391,6 +415,9 @@ const (
 	ColumnOptionGenerated
 	ColumnOptionReference
 	ColumnOptionCollate
+	ColumnOptionEngineAttribute
+	// ColumnOptionCompressed is folded into the column type by the parser.
+	ColumnOptionCompressed
 )
 
 var (
@@ -415,6 +442,8 @@ type ColumnOption struct {
 	// Refer is used for foreign key.
 	Refer    *ReferenceDef
 	StrValue string
+	// EngineAttribute is only for ColumnOptionEngineAttribute.
+	EngineAttribute *model.EngineAttribute
 }
 
 // Restore implements Node interface.
@@ -471,6 +500,10 @@ func (n *ColumnOption) Restore(ctx *format.RestoreCtx) error {
 		}
 		ctx.WriteKeyWord("COLLATE ")
 		ctx.WritePlain(n.StrValue)
+	case ColumnOptionEngineAttribute:
+		restoreEngineAttribute(ctx, n.EngineAttribute)
+	case ColumnOptionCompressed:
+		ctx.WriteKeyWord("COMPRESSED")
 	default:
 		return errors.New("An error occurred while splicing ColumnOption")
 	}
@@ -499,13 +532,17 @@ func (n *ColumnOption) Accept(v Visitor) (Node, bool) {
 //  | index_type
 //  | WITH PARSER parser_name
 //  | COMMENT 'string'
+//  | engine_option = value
 // See http://dev.mysql.com/doc/refman/5.7/en/create-table.html
 type IndexOption struct {
 	node
 
-	KeyBlockSize uint64
-	Tp           model.IndexType
-	Comment      string
+	KeyBlockSize     uint64
+	Tp               model.IndexType
+	Comment          string
+	EngineAttributes []*model.EngineAttribute
+	// VectorInfo is only for vector indexes.
+	VectorInfo *model.VectorIndexInfo
 }
 
 // Restore implements Node interface.
@@ -532,6 +569,34 @@ func (n *IndexOption) Restore(ctx *format.RestoreCtx) error {
 		}
 		ctx.WriteKeyWord("COMMENT ")
 		ctx.WriteString(n.Comment)
+		hasPrevOption = true
+	}
+
+	for _, attr := range n.EngineAttributes {
+		if hasPrevOption {
+			ctx.WritePlain(" ")
+		}
+		restoreEngineAttribute(ctx, attr)
+		hasPrevOption = true
+	}
+
+	if n.VectorInfo != nil {
+		if n.VectorInfo.M > 0 {
+			if hasPrevOption {
+				ctx.WritePlain(" ")
+			}
+			ctx.WriteKeyWord("M")
+			ctx.WritePlainf(" = %d", n.VectorInfo.M)
+			hasPrevOption = true
+		}
+		if n.VectorInfo.Distance != model.VectorDistanceDefault {
+			if hasPrevOption {
+				ctx.WritePlain(" ")
+			}
+			ctx.WriteKeyWord("DISTANCE")
+			ctx.WritePlain(" = ")
+			ctx.WriteKeyWord(n.VectorInfo.Distance.String())
+		}
 	}
 	return nil
 }
@@ -560,12 +625,16 @@ const (
 	ConstraintUniqIndex
 	ConstraintForeignKey
 	ConstraintFulltext
+	ConstraintVector
 )
 
 // Constraint is constraint for table definition.
 type Constraint struct {
 	node
 
+	// only supported by MariaDB, see https://mariadb.com/kb/en/alter-table/
+	IfNotExists bool
+
 	Tp   ConstraintType
 	Name string
 
@@ -595,6 +664,8 @@ func (n *Constraint) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("UNIQUE INDEX")
 	case ConstraintFulltext:
 		ctx.WriteKeyWord("FULLTEXT")
+	case ConstraintVector:
+		ctx.WriteKeyWord("VECTOR INDEX")
 	}
 
 	if n.Tp == ConstraintForeignKey {
@@ -604,9 +675,20 @@ func (n *Constraint) Restore(ctx *format.RestoreCtx) error {
 			ctx.WritePlain(" ")
 		}
 		ctx.WriteKeyWord("FOREIGN KEY ")
-	} else if n.Name != "" {
-		ctx.WritePlain(" ")
-		ctx.WriteName(n.Name)
+		if n.IfNotExists {
+			ctx.WriteKeyWord("IF NOT EXISTS ")
+		}
+	} else {
+		if n.IfNotExists {
+			ctx.WriteKeyWord(" IF NOT EXISTS")
+			if n.Name == "" {
+				ctx.WritePlain(" ")
+			}
+		}
+		if n.Name != "" {
+			ctx.WritePlain(" ")
+			ctx.WriteName(n.Name)
+		}
 	}
 
 	ctx.WritePlain("(")
@@ -741,6 +823,7 @@ func (n *ColumnDef) Validate() bool {
 type CreateTableStmt struct {
 	ddlNode
 
+	OrReplace   bool
 	IfNotExists bool
 	Table       *TableName
 	ReferTable  *TableName
@@ -754,7 +837,11 @@ type CreateTableStmt struct {
 
 // Restore implements Node interface.
 func (n *CreateTableStmt) Restore(ctx *format.RestoreCtx) error {
-	ctx.WriteKeyWord("CREATE TABLE ")
+	ctx.WriteKeyWord("CREATE ")
+	if n.OrReplace {
+		ctx.WriteKeyWord("OR REPLACE ")
+	}
+	ctx.WriteKeyWord("TABLE ")
 	if n.IfNotExists {
 		ctx.WriteKeyWord("IF NOT EXISTS ")
 	}
@@ -1111,18 +1198,26 @@ func (n *CreateViewStmt) Accept(v Visitor) (Node, bool) {
 type CreateIndexStmt struct {
 	ddlNode
 
+	OrReplace     bool
 	IndexName     string
 	Table         *TableName
 	Unique        bool
+	Vector        bool
 	IndexColNames []*IndexColName
 	IndexOption   *IndexOption
+	LockAlg       *LockAndAlgorithm
 }
 
 // Restore implements Node interface.
 func (n *CreateIndexStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("CREATE ")
+	if n.OrReplace {
+		ctx.WriteKeyWord("OR REPLACE ")
+	}
 	if n.Unique {
 		ctx.WriteKeyWord("UNIQUE ")
+	} else if n.Vector {
+		ctx.WriteKeyWord("VECTOR ")
 	}
 	ctx.WriteKeyWord("INDEX ")
 	ctx.WriteName(n.IndexName)
@@ -1142,13 +1237,20 @@ func (n *CreateIndexStmt) Restore(ctx *format.RestoreCtx) error {
 	}
 	ctx.WritePlain(")")
 
-	if n.IndexOption.Tp != model.IndexTypeInvalid || n.IndexOption.KeyBlockSize > 0 || n.IndexOption.Comment != "" {
+	if n.IndexOption.Tp != model.IndexTypeInvalid || n.IndexOption.KeyBlockSize > 0 || n.IndexOption.Comment != "" || len(n.IndexOption.EngineAttributes) > 0 || n.IndexOption.VectorInfo != nil {
 		ctx.WritePlain(" ")
 		if err := n.IndexOption.Restore(ctx); err != nil {
 			return errors.Annotate(err, "An error occurred while restore CreateIndexStmt.IndexOption")
 		}
 	}
 
+	if n.LockAlg != nil && !n.LockAlg.isEmpty() {
+		ctx.WritePlain(" ")
+		if err := n.LockAlg.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore CreateIndexStmt.LockAlg")
+		}
+	}
+
 	return nil
 }
 
@@ -1178,6 +1280,13 @@ func (n *CreateIndexStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.IndexOption = node.(*IndexOption)
 	}
+	if n.LockAlg != nil {
+		node, ok := n.LockAlg.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.LockAlg = node.(*LockAndAlgorithm)
+	}
 	return v.Leave(n)
 }
 
@@ -1189,6 +1298,7 @@ type DropIndexStmt struct {
 	IfExists  bool
 	IndexName string
 	Table     *TableName
+	LockAlg   *LockAndAlgorithm
 }
 
 // Restore implements Node interface.
@@ -1204,6 +1314,13 @@ func (n *DropIndexStmt) Restore(ctx *format.RestoreCtx) error {
 		return errors.Annotate(err, "An error occurred while add index")
 	}
 
+	if n.LockAlg != nil && !n.LockAlg.isEmpty() {
+		ctx.WritePlain(" ")
+		if err := n.LockAlg.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore DropIndexStmt.LockAlg")
+		}
+	}
+
 	return nil
 }
 
@@ -1219,6 +1336,13 @@ func (n *DropIndexStmt) Accept(v Visitor) (Node, bool) {
 		return n, false
 	}
 	n.Table = node.(*TableName)
+	if n.LockAlg != nil {
+		node, ok = n.LockAlg.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.LockAlg = node.(*LockAndAlgorithm)
+	}
 	return v.Leave(n)
 }
 
@@ -1246,6 +1370,11 @@ const (
 	TableOptionStatsPersistent
 	TableOptionShardRowID
 	TableOptionPackKeys
+	TableOptionPageCompressed
+	TableOptionPageCompressionLevel
+	TableOptionEncrypted
+	TableOptionEncryptionKeyID
+	TableOptionEngineAttribute
 )
 
 // RowFormat types
@@ -1280,8 +1409,11 @@ const (
 // TableOption is used for parsing table option from SQL.
 type TableOption struct {
 	Tp        TableOptionType
+	Default   bool
 	StrValue  string
 	UintValue uint64
+	// EngineAttribute is only for TableOptionEngineAttribute.
+	EngineAttribute *model.EngineAttribute
 }
 
 // Restore implements Node interface.
@@ -1298,7 +1430,11 @@ func (n *TableOption) Restore(ctx *format.RestoreCtx) error {
 	case TableOptionCharset:
 		ctx.WriteKeyWord("DEFAULT CHARACTER SET ")
 		ctx.WritePlain("= ")
-		ctx.WritePlain(n.StrValue)
+		if n.Default {
+			ctx.WriteKeyWord("DEFAULT")
+		} else {
+			ctx.WritePlain(n.StrValue)
+		}
 	case TableOptionCollate:
 		ctx.WriteKeyWord("DEFAULT COLLATE ")
 		ctx.WritePlain("= ")
@@ -1398,12 +1534,50 @@ func (n *TableOption) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlain("= ")
 		ctx.WriteKeyWord("DEFAULT")
 		ctx.WritePlain("/* TableOptionPackKeys is not supported */")
+	case TableOptionPageCompressed:
+		ctx.WriteKeyWord("PAGE_COMPRESSED ")
+		ctx.WritePlain("= ")
+		ctx.WritePlainf("%d", n.UintValue)
+	case TableOptionPageCompressionLevel:
+		ctx.WriteKeyWord("PAGE_COMPRESSION_LEVEL ")
+		ctx.WritePlain("= ")
+		ctx.WritePlainf("%d", n.UintValue)
+	case TableOptionEncrypted:
+		ctx.WriteKeyWord("ENCRYPTED ")
+		ctx.WritePlain("= ")
+		if n.UintValue != 0 {
+			ctx.WriteKeyWord("YES")
+		} else {
+			ctx.WriteKeyWord("NO")
+		}
+	case TableOptionEncryptionKeyID:
+		ctx.WriteKeyWord("ENCRYPTION_KEY_ID ")
+		ctx.WritePlain("= ")
+		ctx.WritePlainf("%d", n.UintValue)
+	case TableOptionEngineAttribute:
+		restoreEngineAttribute(ctx, n.EngineAttribute)
 	default:
 		return errors.Errorf("invalid TableOption: %d", n.Tp)
 	}
 	return nil
 }
 
+// restoreEngineAttribute restores an engine defined `name = value` attribute of a table, column or index.
+func restoreEngineAttribute(ctx *format.RestoreCtx, attr *model.EngineAttribute) {
+	ctx.WriteName(attr.Name)
+	ctx.WritePlain(" = ")
+	switch attr.Tp {
+	case model.EngineAttributeValueString:
+		ctx.WriteString(attr.Value)
+	case model.EngineAttributeValueNumber:
+		ctx.WritePlain(attr.Value)
+	case model.EngineAttributeValueIdent:
+		ctx.WriteName(attr.Value)
+	case model.EngineAttributeValueDefault:
+		ctx.WriteKeyWord("DEFAULT")
+	}
+}
+
 // ColumnPositionType is the type for ColumnPosition.
 type ColumnPositionType int
 
@@ -1482,6 +1656,11 @@ const (
 	AlterTableCoalescePartitions
 	AlterTableDropPartition
 	AlterTableTruncatePartition
+	AlterTableEnableKeys
+	AlterTableDisableKeys
+	AlterTableOrderByColumns
+	AlterTableDiscardTablespace
+	AlterTableImportTablespace
 
 	// TODO: Add more actions
 )
@@ -1524,6 +1703,7 @@ const (
 	AlterAlgorithmCopy
 	AlterAlgorithmInplace
 	AlterAlgorithmInstant
+	AlterAlgorithmNocopy
 )
 
 func (a AlterAlgorithm) String() string {
@@ -1536,15 +1716,104 @@ func (a AlterAlgorithm) String() string {
 		return "INPLACE"
 	case AlterAlgorithmInstant:
 		return "INSTANT"
+	case AlterAlgorithmNocopy:
+		return "NOCOPY"
 	default:
 		return "DEFAULT"
 	}
 }
 
+// LockAndAlgorithm is the online DDL option shared by ALTER TABLE, CREATE INDEX
+// and DROP INDEX, which is the ALGORITHM and LOCK clauses.
+// See https://mariadb.com/kb/en/alter-table/#algorithm
+type LockAndAlgorithm struct {
+	node
+
+	LockType  LockType
+	Algorithm AlterAlgorithm
+	// HasAlgorithm is true if the ALGORITHM clause is given, even as ALGORITHM = DEFAULT.
+	HasAlgorithm bool
+}
+
+func (n *LockAndAlgorithm) hasAlgorithm() bool {
+	return n.HasAlgorithm || n.Algorithm != AlterAlgorithmDefault
+}
+
+// isEmpty returns true if neither the ALGORITHM nor the LOCK clause is given.
+func (n *LockAndAlgorithm) isEmpty() bool {
+	return !n.hasAlgorithm() && n.LockType == 0
+}
+
+// Restore implements Node interface.
+func (n *LockAndAlgorithm) Restore(ctx *format.RestoreCtx) error {
+	if n.hasAlgorithm() {
+		ctx.WriteKeyWord("ALGORITHM ")
+		ctx.WritePlain("= ")
+		ctx.WriteKeyWord(n.Algorithm.String())
+		if n.LockType != 0 {
+			ctx.WritePlain(" ")
+		}
+	}
+
+	if n.LockType != 0 {
+		ctx.WriteKeyWord("LOCK ")
+		ctx.WritePlain("= ")
+		ctx.WriteKeyWord(n.LockType.String())
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *LockAndAlgorithm) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*LockAndAlgorithm)
+	return v.Leave(n)
+}
+
+// AlterOrderItem represents an item in order by at alter table stmt.
+type AlterOrderItem struct {
+	node
+	Column *ColumnName
+	Desc   bool
+}
+
+// Restore implements Node interface.
+func (n *AlterOrderItem) Restore(ctx *format.RestoreCtx) error {
+	if err := n.Column.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterOrderItem.Column")
+	}
+	if n.Desc {
+		ctx.WriteKeyWord(" DESC")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *AlterOrderItem) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AlterOrderItem)
+	node, ok := n.Column.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Column = node.(*ColumnName)
+	return v.Leave(n)
+}
+
 // AlterTableSpec represents alter table specification.
 type AlterTableSpec struct {
 	node
 
+	// only supported by MariaDB, see https://mariadb.com/kb/en/alter-table/
+	IfExists    bool
+	IfNotExists bool
+
 	Tp              AlterTableType
 	Name            string
 	Constraint      *Constraint
@@ -1559,6 +1828,7 @@ type AlterTableSpec struct {
 	FromKey         model.CIStr
 	ToKey           model.CIStr
 	PartDefinitions []*PartitionDefinition
+	OrderByList     []*AlterOrderItem
 	Num             uint64
 }
 
@@ -1571,9 +1841,17 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 			n.Options[0].Tp == TableOptionCharset &&
 			n.Options[1].Tp == TableOptionCollate:
 			ctx.WriteKeyWord("CONVERT TO CHARACTER SET ")
-			ctx.WriteKeyWord(n.Options[0].StrValue)
+			if n.Options[0].Default {
+				ctx.WriteKeyWord("DEFAULT")
+			} else {
+				ctx.WritePlain(n.Options[0].StrValue)
+			}
 			ctx.WriteKeyWord(" COLLATE ")
-			ctx.WriteKeyWord(n.Options[1].StrValue)
+			ctx.WritePlain(n.Options[1].StrValue)
+		case len(n.Options) == 1 &&
+			n.Options[0].Tp == TableOptionCharset &&
+			n.Options[0].Default:
+			ctx.WriteKeyWord("CONVERT TO CHARACTER SET DEFAULT")
 		default:
 			for i, opt := range n.Options {
 				if i != 0 {
@@ -1586,6 +1864,9 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 		}
 	case AlterTableAddColumns:
 		ctx.WriteKeyWord("ADD COLUMN ")
+		if n.IfNotExists {
+			ctx.WriteKeyWord("IF NOT EXISTS ")
+		}
 		if n.Position != nil && len(n.NewColumns) == 1 {
 			if err := n.NewColumns[0].Restore(ctx); err != nil {
 				return errors.Annotatef(err, "An error occurred while restore AlterTableSpec.NewColumns[%d]", 0)
@@ -1615,6 +1896,9 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 		}
 	case AlterTableDropColumn:
 		ctx.WriteKeyWord("DROP COLUMN ")
+		if n.IfExists {
+			ctx.WriteKeyWord("IF EXISTS ")
+		}
 		if err := n.OldColumnName.Restore(ctx); err != nil {
 			return errors.Annotate(err, "An error occurred while restore AlterTableSpec.OldColumnName")
 		}
@@ -1623,12 +1907,21 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("DROP PRIMARY KEY")
 	case AlterTableDropIndex:
 		ctx.WriteKeyWord("DROP INDEX ")
+		if n.IfExists {
+			ctx.WriteKeyWord("IF EXISTS ")
+		}
 		ctx.WriteName(n.Name)
 	case AlterTableDropForeignKey:
 		ctx.WriteKeyWord("DROP FOREIGN KEY ")
+		if n.IfExists {
+			ctx.WriteKeyWord("IF EXISTS ")
+		}
 		ctx.WriteName(n.Name)
 	case AlterTableModifyColumn:
 		ctx.WriteKeyWord("MODIFY COLUMN ")
+		if n.IfExists {
+			ctx.WriteKeyWord("IF EXISTS ")
+		}
 		if err := n.NewColumns[0].Restore(ctx); err != nil {
 			return errors.Annotate(err, "An error occurred while restore AlterTableSpec.NewColumns[0]")
 		}
@@ -1640,6 +1933,9 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 		}
 	case AlterTableChangeColumn:
 		ctx.WriteKeyWord("CHANGE COLUMN ")
+		if n.IfExists {
+			ctx.WriteKeyWord("IF EXISTS ")
+		}
 		if err := n.OldColumnName.Restore(ctx); err != nil {
 			return errors.Annotate(err, "An error occurred while restore AlterTableSpec.OldColumnName")
 		}
@@ -1660,6 +1956,9 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 		}
 	case AlterTableAlterColumn:
 		ctx.WriteKeyWord("ALTER COLUMN ")
+		if n.IfExists {
+			ctx.WriteKeyWord("IF EXISTS ")
+		}
 		if err := n.NewColumns[0].Restore(ctx); err != nil {
 			return errors.Annotate(err, "An error occurred while restore AlterTableSpec.NewColumns[0]")
 		}
@@ -1681,6 +1980,9 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord(n.Algorithm.String())
 	case AlterTableRenameIndex:
 		ctx.WriteKeyWord("RENAME INDEX ")
+		if n.IfExists {
+			ctx.WriteKeyWord("IF EXISTS ")
+		}
 		ctx.WriteName(n.FromKey.O)
 		ctx.WriteKeyWord(" TO ")
 		ctx.WriteName(n.ToKey.O)
@@ -1690,6 +1992,9 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlain("/* AlterTableForce is not supported */")
 	case AlterTableAddPartitions:
 		ctx.WriteKeyWord("ADD PARTITION")
+		if n.IfNotExists {
+			ctx.WriteKeyWord(" IF NOT EXISTS")
+		}
 		if n.PartDefinitions != nil {
 			ctx.WritePlain(" (")
 			for i, def := range n.PartDefinitions {
@@ -1710,10 +2015,31 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlainf("%d", n.Num)
 	case AlterTableDropPartition:
 		ctx.WriteKeyWord("DROP PARTITION ")
+		if n.IfExists {
+			ctx.WriteKeyWord("IF EXISTS ")
+		}
 		ctx.WriteName(n.Name)
 	case AlterTableTruncatePartition:
 		ctx.WriteKeyWord("TRUNCATE PARTITION ")
 		ctx.WriteName(n.Name)
+	case AlterTableEnableKeys:
+		ctx.WriteKeyWord("ENABLE KEYS")
+	case AlterTableDisableKeys:
+		ctx.WriteKeyWord("DISABLE KEYS")
+	case AlterTableOrderByColumns:
+		ctx.WriteKeyWord("ORDER BY ")
+		for i, alterOrderItem := range n.OrderByList {
+			if i != 0 {
+				ctx.WritePlain(", ")
+			}
+			if err := alterOrderItem.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore AlterTableSpec.OrderByList[%d]", i)
+			}
+		}
+	case AlterTableDiscardTablespace:
+		ctx.WriteKeyWord("DISCARD TABLESPACE")
+	case AlterTableImportTablespace:
+		ctx.WriteKeyWord("IMPORT TABLESPACE")
 	default:
 		// TODO: not support
 		ctx.WritePlainf("/* AlterTableType(%d) is not supported */", n.Tp)
@@ -1763,6 +2089,13 @@ func (n *AlterTableSpec) Accept(v Visitor) (Node, bool) {
 		}
 		n.Position = node.(*ColumnPosition)
 	}
+	for i, val := range n.OrderByList {
+		node, ok := val.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.OrderByList[i] = node.(*AlterOrderItem)
+	}
 	return v.Leave(n)
 }
 
@@ -1771,13 +2104,18 @@ func (n *AlterTableSpec) Accept(v Visitor) (Node, bool) {
 type AlterTableStmt struct {
 	ddlNode
 
-	Table *TableName
-	Specs []*AlterTableSpec
+	// IfExists is only supported by MariaDB.
+	IfExists bool
+	Table    *TableName
+	Specs    []*AlterTableSpec
 }
 
 // Restore implements Node interface.
 func (n *AlterTableStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("ALTER TABLE ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
 	if err := n.Table.Restore(ctx); err != nil {
 		return errors.Annotate(err, "An error occurred while restore AlterTableStmt.Table")
 	}
@@ -1816,6 +2154,28 @@ func (n *AlterTableStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// LockAndAlgorithm collects the LOCK and ALGORITHM specs of the statement,
+// it returns nil if neither is specified. When a clause is given more than
+// once, the last one takes effect.
+func (n *AlterTableStmt) LockAndAlgorithm() *LockAndAlgorithm {
+	var lockAlg *LockAndAlgorithm
+	for _, spec := range n.Specs {
+		switch spec.Tp {
+		case AlterTableLock, AlterTableAlgorithm:
+			if lockAlg == nil {
+				lockAlg = &LockAndAlgorithm{}
+			}
+			if spec.Tp == AlterTableLock {
+				lockAlg.LockType = spec.LockType
+			} else {
+				lockAlg.Algorithm = spec.Algorithm
+				lockAlg.HasAlgorithm = true
+			}
+		}
+	}
+	return lockAlg
+}
+
 // TruncateTableStmt is a statement to empty a table completely.
 // See https://dev.mysql.com/doc/refman/5.7/en/truncate-table.html
 type TruncateTableStmt struct {
@@ -1848,6 +2208,204 @@ func (n *TruncateTableStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// ServerOptionType is the type for server options.
+type ServerOptionType int
+
+// Server option types.
+const (
+	ServerOptionNone ServerOptionType = iota
+	ServerOptionHost
+	ServerOptionDatabase
+	ServerOptionUser
+	ServerOptionPassword
+	ServerOptionPort
+	ServerOptionSocket
+	ServerOptionOwner
+)
+
+// ServerOption is used for parsing the OPTIONS clause of server definitions.
+type ServerOption struct {
+	Tp        ServerOptionType
+	StrValue  string
+	UintValue uint64
+}
+
+// Restore implements Node interface.
+func (n *ServerOption) Restore(ctx *format.RestoreCtx) error {
+	return n.restore(ctx, false)
+}
+
+func (n *ServerOption) restore(ctx *format.RestoreCtx, redact bool) error {
+	switch n.Tp {
+	case ServerOptionHost:
+		ctx.WriteKeyWord("HOST ")
+	case ServerOptionDatabase:
+		ctx.WriteKeyWord("DATABASE ")
+	case ServerOptionUser:
+		ctx.WriteKeyWord("USER ")
+	case ServerOptionPassword:
+		ctx.WriteKeyWord("PASSWORD ")
+		if redact {
+			ctx.WritePlain("***")
+			return nil
+		}
+	case ServerOptionPort:
+		ctx.WriteKeyWord("PORT ")
+		ctx.WritePlainf("%d", n.UintValue)
+		return nil
+	case ServerOptionSocket:
+		ctx.WriteKeyWord("SOCKET ")
+	case ServerOptionOwner:
+		ctx.WriteKeyWord("OWNER ")
+	default:
+		return errors.Errorf("invalid ServerOption: %d", n.Tp)
+	}
+	ctx.WriteString(n.StrValue)
+	return nil
+}
+
+func restoreServerOptions(ctx *format.RestoreCtx, options []*ServerOption, redact bool) error {
+	ctx.WriteKeyWord(" OPTIONS ")
+	ctx.WritePlain("(")
+	for i, option := range options {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := option.restore(ctx, redact); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore ServerOption[%d]", i)
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// CreateServerStmt is a statement to create a server definition used by the FEDERATED and Spider engines.
+// See https://mariadb.com/kb/en/create-server/
+type CreateServerStmt struct {
+	ddlNode
+
+	OrReplace   bool
+	IfNotExists bool
+	Name        string
+	Wrapper     string
+	Options     []*ServerOption
+}
+
+// Restore implements Node interface.
+func (n *CreateServerStmt) Restore(ctx *format.RestoreCtx) error {
+	return n.restore(ctx, false)
+}
+
+func (n *CreateServerStmt) restore(ctx *format.RestoreCtx, redact bool) error {
+	ctx.WriteKeyWord("CREATE ")
+	if n.OrReplace {
+		ctx.WriteKeyWord("OR REPLACE ")
+	}
+	ctx.WriteKeyWord("SERVER ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	ctx.WriteName(n.Name)
+	ctx.WriteKeyWord(" FOREIGN DATA WRAPPER ")
+	ctx.WriteName(n.Wrapper)
+	if err := restoreServerOptions(ctx, n.Options, redact); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateServerStmt.Options")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateServerStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateServerStmt)
+	return v.Leave(n)
+}
+
+// SecureText implements SensitiveStatement interface.
+func (n *CreateServerStmt) SecureText() string {
+	var sb strings.Builder
+	if err := n.restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb), true); err != nil {
+		// Drop the options rather than return a partial text.
+		return "create server " + n.Name
+	}
+	return sb.String()
+}
+
+// AlterServerStmt is a statement to change the options of a server definition.
+// See https://mariadb.com/kb/en/alter-server/
+type AlterServerStmt struct {
+	ddlNode
+
+	Name    string
+	Options []*ServerOption
+}
+
+// Restore implements Node interface.
+func (n *AlterServerStmt) Restore(ctx *format.RestoreCtx) error {
+	return n.restore(ctx, false)
+}
+
+func (n *AlterServerStmt) restore(ctx *format.RestoreCtx, redact bool) error {
+	ctx.WriteKeyWord("ALTER SERVER ")
+	ctx.WriteName(n.Name)
+	if err := restoreServerOptions(ctx, n.Options, redact); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterServerStmt.Options")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *AlterServerStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AlterServerStmt)
+	return v.Leave(n)
+}
+
+// SecureText implements SensitiveStatement interface.
+func (n *AlterServerStmt) SecureText() string {
+	var sb strings.Builder
+	if err := n.restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb), true); err != nil {
+		// Drop the options rather than return a partial text.
+		return "alter server " + n.Name
+	}
+	return sb.String()
+}
+
+// DropServerStmt is a statement to drop a server definition.
+// See https://mariadb.com/kb/en/drop-server/
+type DropServerStmt struct {
+	ddlNode
+
+	IfExists bool
+	Name     string
+}
+
+// Restore implements Node interface.
+func (n *DropServerStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP SERVER ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	ctx.WriteName(n.Name)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropServerStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropServerStmt)
+	return v.Leave(n)
+}
+
 // PartitionDefinition defines a single partition.
 type PartitionDefinition struct {
 	Name     model.CIStr
@@ -30,6 +30,12 @@ func (ts *testDDLSuite) TestDDLVisitorCover(c *C) {
 
 	alterTableSpec := &AlterTableSpec{Constraint: constraint, Options: []*TableOption{{}}, NewTable: &TableName{}, NewColumns: []*ColumnDef{{Name: &ColumnName{}}}, OldColumnName: &ColumnName{}, Position: &ColumnPosition{RelativeColumn: &ColumnName{}}}
 
+	block := &ProcedureBlock{
+		Decls:    []*ProcedureDecl{{Tp: &VariableType{}, Default: ce}},
+		Stmts:    []StmtNode{&ProcedureAssignmentStmt{Expr: ce}, &ProcedureNullStmt{}},
+		Handlers: []*ProcedureExceptionHandler{{Stmts: []StmtNode{&ProcedureNullStmt{}}}},
+	}
+
 	stmts := []struct {
 		node             Node
 		expectedEnterCnt int
@@ -38,7 +44,11 @@ func (ts *testDDLSuite) TestDDLVisitorCover(c *C) {
 		{&CreateDatabaseStmt{}, 0, 0},
 		{&AlterDatabaseStmt{}, 0, 0},
 		{&DropDatabaseStmt{}, 0, 0},
+		{&CreateServerStmt{}, 0, 0},
+		{&AlterServerStmt{}, 0, 0},
+		{&DropServerStmt{}, 0, 0},
 		{&DropIndexStmt{Table: &TableName{}}, 0, 0},
+		{&DropIndexStmt{Table: &TableName{}, LockAlg: &LockAndAlgorithm{}}, 0, 0},
 		{&DropTableStmt{Tables: []*TableName{{}, {}}}, 0, 0},
 		{&RenameTableStmt{OldTable: &TableName{}, NewTable: &TableName{}}, 0, 0},
 		{&TruncateTableStmt{Table: &TableName{}}, 0, 0},
@@ -48,12 +58,15 @@ func (ts *testDDLSuite) TestDDLVisitorCover(c *C) {
 		{&CreateIndexStmt{Table: &TableName{}}, 0, 0},
 		{&CreateTableStmt{Table: &TableName{}, ReferTable: &TableName{}}, 0, 0},
 		{&CreateViewStmt{ViewName: &TableName{}, Select: &SelectStmt{}}, 0, 0},
+		{&CreateProcedureStmt{Params: []*ProcedureParam{{Tp: &VariableType{AnchorColumn: &ColumnName{}}}}, Block: block}, 2, 2},
+		{&CreatePackageStmt{Decls: []*ProcedureDecl{{Tp: &VariableType{AnchorTable: &TableName{}}, Default: ce}}, Procedures: []*PackageProcedure{{Block: block}, {}}}, 3, 3},
 		{&AlterTableSpec{}, 0, 0},
 		{&ColumnDef{Name: &ColumnName{}, Options: []*ColumnOption{{Expr: ce}}}, 1, 1},
 		{&ColumnOption{Expr: ce}, 1, 1},
 		{&ColumnPosition{RelativeColumn: &ColumnName{}}, 0, 0},
 		{&Constraint{Keys: []*IndexColName{{Column: &ColumnName{}}, {Column: &ColumnName{}}}, Refer: &ReferenceDef{}, Option: &IndexOption{}}, 0, 0},
 		{&IndexColName{Column: &ColumnName{}}, 0, 0},
+		{&LockAndAlgorithm{}, 0, 0},
 		{&ReferenceDef{Table: &TableName{}, IndexColNames: []*IndexColName{{Column: &ColumnName{}}, {Column: &ColumnName{}}}, OnDelete: &OnDeleteOpt{}, OnUpdate: &OnUpdateOpt{}}, 0, 0},
 	}
 
@@ -121,6 +134,20 @@ func (ts *testDDLSuite) TestDDLIndexOption(c *C) {
 	RunNodeRestoreTest(c, testCases, "CREATE INDEX idx ON t (a) %s", extractNodeFunc)
 }
 
+func (ts *testDDLSuite) TestDDLLockAndAlgorithmRestore(c *C) {
+	testCases := []NodeRestoreTestCase{
+		{"lock=none", "LOCK = NONE"},
+		{"lock default", "LOCK = DEFAULT"},
+		{"algorithm=nocopy", "ALGORITHM = NOCOPY"},
+		{"algorithm instant lock exclusive", "ALGORITHM = INSTANT LOCK = EXCLUSIVE"},
+		{"lock = shared algorithm = inplace", "ALGORITHM = INPLACE LOCK = SHARED"},
+	}
+	extractNodeFunc := func(node Node) Node {
+		return node.(*DropIndexStmt).LockAlg
+	}
+	RunNodeRestoreTest(c, testCases, "DROP INDEX idx ON t %s", extractNodeFunc)
+}
+
 func (ts *testDDLSuite) TestTableToTableRestore(c *C) {
 	testCases := []NodeRestoreTestCase{
 		{"t1 to t2", "`t1` TO `t2`"},
@@ -403,6 +430,25 @@ func (ts *testDDLSuite) TestAlterTableSpecRestore(c *C) {
 		{"coalesce partition 3", "COALESCE PARTITION 3"},
 		{"drop partition p1", "DROP PARTITION `p1`"},
 		{"TRUNCATE PARTITION p0", "TRUNCATE PARTITION `p0`"},
+		{"CONVERT TO CHARACTER SET DEFAULT", "CONVERT TO CHARACTER SET DEFAULT"},
+		{"CONVERT TO CHARSET DEFAULT COLLATE utf8_bin", "CONVERT TO CHARACTER SET DEFAULT COLLATE utf8_bin"},
+		{"ADD COLUMN IF NOT EXISTS a SMALLINT UNSIGNED", "ADD COLUMN IF NOT EXISTS `a` SMALLINT UNSIGNED"},
+		{"ADD IF NOT EXISTS (a SMALLINT UNSIGNED, b INT)", "ADD COLUMN IF NOT EXISTS (`a` SMALLINT UNSIGNED, `b` INT)"},
+		{"ADD KEY IF NOT EXISTS k (a)", "ADD INDEX IF NOT EXISTS `k`(`a`)"},
+		{"ADD UNIQUE INDEX IF NOT EXISTS (a)", "ADD UNIQUE IF NOT EXISTS (`a`)"},
+		{"ADD CONSTRAINT fk FOREIGN KEY IF NOT EXISTS (a) REFERENCES p(id)", "ADD CONSTRAINT `fk` FOREIGN KEY IF NOT EXISTS (`a`) REFERENCES `p`(`id`)"},
+		{"ADD PARTITION IF NOT EXISTS (PARTITION p1 VALUES LESS THAN (10))", "ADD PARTITION IF NOT EXISTS (PARTITION `p1` VALUES LESS THAN (10))"},
+		{"DROP COLUMN IF EXISTS a", "DROP COLUMN IF EXISTS `a`"},
+		{"DROP INDEX IF EXISTS a", "DROP INDEX IF EXISTS `a`"},
+		{"DROP FOREIGN KEY IF EXISTS a", "DROP FOREIGN KEY IF EXISTS `a`"},
+		{"DROP PARTITION IF EXISTS p1", "DROP PARTITION IF EXISTS `p1`"},
+		{"MODIFY COLUMN IF EXISTS a INT FIRST", "MODIFY COLUMN IF EXISTS `a` INT FIRST"},
+		{"CHANGE IF EXISTS a b INT", "CHANGE COLUMN IF EXISTS `a` `b` INT"},
+		{"ENABLE KEYS", "ENABLE KEYS"},
+		{"DISABLE KEYS", "DISABLE KEYS"},
+		{"DISCARD TABLESPACE", "DISCARD TABLESPACE"},
+		{"IMPORT TABLESPACE", "IMPORT TABLESPACE"},
+		{"ORDER BY a, b DESC, c ASC", "ORDER BY `a`, `b` DESC, `c`"},
 	}
 	extractNodeFunc := func(node Node) Node {
 		return node.(*AlterTableStmt).Specs[0]
@@ -374,6 +374,9 @@ type TableSource struct {
 
 	// AsName is the alias name of the table source.
 	AsName model.CIStr
+
+	// ColumnNames is the column alias list of a derived table, e.g. (VALUES (1, 2)) AS t(a, b).
+	ColumnNames []model.CIStr
 }
 
 // Restore implements Node interface.
@@ -396,6 +399,16 @@ func (n *TableSource) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord(" AS ")
 		ctx.WriteName(asName)
 	}
+	if len(n.ColumnNames) > 0 {
+		ctx.WritePlain("(")
+		for i, col := range n.ColumnNames {
+			if i != 0 {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteName(col.String())
+		}
+		ctx.WritePlain(")")
+	}
 
 	return nil
 }
@@ -631,6 +644,8 @@ func (n *ByItem) Accept(v Visitor) (Node, bool) {
 type GroupByClause struct {
 	node
 	Items []*ByItem
+	// Rollup is true for GROUP BY ... WITH ROLLUP, which adds the super-aggregate rows.
+	Rollup bool
 }
 
 // Restore implements Node interface.
@@ -644,6 +659,9 @@ func (n *GroupByClause) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotatef(err, "An error occurred while restore GroupByClause.Items[%d]", i)
 		}
 	}
+	if n.Rollup {
+		ctx.WriteKeyWord(" WITH ROLLUP")
+	}
 	return nil
 }
 
@@ -732,6 +750,17 @@ func (n *OrderByClause) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// SelectStmtKind is the kind of SelectStmt.
+type SelectStmtKind uint8
+
+// SelectStmt kinds.
+const (
+	// SelectStmtKindSelect is an ordinary SELECT query.
+	SelectStmtKindSelect SelectStmtKind = iota
+	// SelectStmtKindValues is a table value constructor, e.g. VALUES (1, 'a'), (2, 'b').
+	SelectStmtKindValues
+)
+
 // SelectStmt represents the select query node.
 // See https://dev.mysql.com/doc/refman/5.7/en/select.html
 type SelectStmt struct {
@@ -766,10 +795,18 @@ type SelectStmt struct {
 	IsAfterUnionDistinct bool
 	// IsInBraces indicates whether it's a stmt in brace.
 	IsInBraces bool
+	// Kind is the kind of the query, SELECT or a table value constructor.
+	Kind SelectStmtKind
+	// Lists is the row list of a table value constructor.
+	// See https://mariadb.com/kb/en/table-value-constructors/
+	Lists [][]ExprNode
 }
 
 // Restore implements Node interface.
 func (n *SelectStmt) Restore(ctx *format.RestoreCtx) error {
+	if n.Kind == SelectStmtKindValues {
+		return n.restoreValues(ctx)
+	}
 	ctx.WriteKeyWord("SELECT ")
 
 	if n.SelectStmtOpts.Priority > 0 {
@@ -888,6 +925,40 @@ func (n *SelectStmt) Restore(ctx *format.RestoreCtx) error {
 	return nil
 }
 
+func (n *SelectStmt) restoreValues(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("VALUES ")
+	for i, row := range n.Lists {
+		if i != 0 {
+			ctx.WritePlain(",")
+		}
+		ctx.WritePlain("(")
+		for j, v := range row {
+			if j != 0 {
+				ctx.WritePlain(",")
+			}
+			if err := v.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore SelectStmt.Lists[%d][%d]", i, j)
+			}
+		}
+		ctx.WritePlain(")")
+	}
+
+	if n.OrderBy != nil {
+		ctx.WritePlain(" ")
+		if err := n.OrderBy.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore SelectStmt.OrderBy")
+		}
+	}
+
+	if n.Limit != nil {
+		ctx.WritePlain(" ")
+		if err := n.Limit.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore SelectStmt.Limit")
+		}
+	}
+	return nil
+}
+
 // Accept implements Node Accept interface.
 func (n *SelectStmt) Accept(v Visitor) (Node, bool) {
 	newNode, skipChildren := v.Enter(n)
@@ -956,6 +1027,16 @@ func (n *SelectStmt) Accept(v Visitor) (Node, bool) {
 		n.WindowSpecs[i] = *node.(*WindowSpec)
 	}
 
+	for i, list := range n.Lists {
+		for j, val := range list {
+			node, ok := val.Accept(v)
+			if !ok {
+				return n, false
+			}
+			n.Lists[i][j] = node.(ExprNode)
+		}
+	}
+
 	if n.OrderBy != nil {
 		node, ok := n.OrderBy.Accept(v)
 		if !ok {
@@ -1145,14 +1226,25 @@ type LoadDataStmt struct {
 	FieldsInfo        *FieldsClause
 	LinesInfo         *LinesClause
 	IgnoreLines       uint64
+	IgnoreRows        bool // IGNORE n ROWS is written instead of IGNORE n LINES.
 	ColumnAssignments []*Assignment
+	Charset           string
 
 	ColumnsAndUserVars []*ColumnNameOrUserVar
+
+	// IsXML indicates the LOAD XML form, which uses RowsIdentifiedBy instead of FieldsInfo and LinesInfo.
+	// See https://mariadb.com/kb/en/load-xml/
+	IsXML            bool
+	RowsIdentifiedBy string
 }
 
 // Restore implements Node interface.
 func (n *LoadDataStmt) Restore(ctx *format.RestoreCtx) error {
-	ctx.WriteKeyWord("LOAD DATA ")
+	if n.IsXML {
+		ctx.WriteKeyWord("LOAD XML ")
+	} else {
+		ctx.WriteKeyWord("LOAD DATA ")
+	}
 	if n.IsLocal {
 		ctx.WriteKeyWord("LOCAL ")
 	}
@@ -1167,12 +1259,27 @@ func (n *LoadDataStmt) Restore(ctx *format.RestoreCtx) error {
 	if err := n.Table.Restore(ctx); err != nil {
 		return errors.Annotate(err, "An error occurred while restore LoadDataStmt.Table")
 	}
-	n.FieldsInfo.Restore(ctx)
-	n.LinesInfo.Restore(ctx)
+	if n.Charset != "" {
+		ctx.WriteKeyWord(" CHARACTER SET ")
+		ctx.WritePlain(n.Charset)
+	}
+	if n.IsXML {
+		if n.RowsIdentifiedBy != "" {
+			ctx.WriteKeyWord(" ROWS IDENTIFIED BY ")
+			ctx.WriteString(n.RowsIdentifiedBy)
+		}
+	} else {
+		n.FieldsInfo.Restore(ctx)
+		n.LinesInfo.Restore(ctx)
+	}
 	if n.IgnoreLines != 0 {
 		ctx.WriteKeyWord(" IGNORE ")
 		ctx.WritePlainf("%d", n.IgnoreLines)
-		ctx.WriteKeyWord(" LINES")
+		if n.IgnoreRows {
+			ctx.WriteKeyWord(" ROWS")
+		} else {
+			ctx.WriteKeyWord(" LINES")
+		}
 	}
 	if len(n.ColumnsAndUserVars) != 0 {
 		ctx.WritePlain(" (")
@@ -1377,9 +1484,18 @@ func (n *InsertStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlain(" ")
 		switch v := n.Select.(type) {
 		case *SelectStmt, *UnionStmt:
+			// A table value constructor is kept in parentheses, which tell it from INSERT ... VALUES.
+			sel, ok := v.(*SelectStmt)
+			values := ok && sel.Kind == SelectStmtKindValues
+			if values {
+				ctx.WritePlain("(")
+			}
 			if err := v.Restore(ctx); err != nil {
 				return errors.Annotate(err, "An error occurred while restore InsertStmt.Select")
 			}
+			if values {
+				ctx.WritePlain(")")
+			}
 		default:
 			return errors.Errorf("Incorrect type for InsertStmt.Select: %T", v)
 		}
@@ -1826,6 +1942,29 @@ const (
 	ShowErrors
 	ShowBindings
 	ShowOpenTables
+	ShowCreateProcedure
+	ShowCreateFunction
+	ShowCreateTrigger
+	ShowCreateEvent
+	ShowCreateSequence
+	ShowCreatePackage
+	ShowCreatePackageBody
+	ShowFunctionStatus
+	ShowProcedureCode
+	ShowFunctionCode
+	ShowEngineStatus
+	ShowEngineMutex
+	ShowBinaryLogs
+	ShowTableStatistics
+	ShowIndexStatistics
+	ShowUserStatistics
+	ShowClientStatistics
+	ShowQueryResponseTime
+	ShowLocales
+	ShowAuthors
+	ShowContributors
+	ShowWsrepStatus
+	ShowWsrepMembership
 )
 
 // TODO:
@@ -1850,8 +1989,10 @@ type ShowStmt struct {
 
 	Tp          ShowStmtType // Databases/Tables/Columns/....
 	DBName      string
-	Table       *TableName  // Used for showing columns.
+	Table       *TableName  // Used for showing columns, and the sequence name of `show create sequence`.
+	Object      *ObjectName // Used for the routine, package, trigger or event name of `show create` and `show procedure|function code`.
 	Column      *ColumnName // Used for `desc table column`.
+	Engine      string      // Used for `show engine ... status|mutex`, empty for `show engine all ...`.
 	Flag        int         // Some flag parsed from sql, such as FULL.
 	Full        bool
 	User        *auth.UserIdentity   // Used for show grants/create user.
@@ -2015,6 +2156,53 @@ func (n *ShowStmt) Restore(ctx *format.RestoreCtx) error {
 		}
 	case ShowPrivileges:
 		ctx.WriteKeyWord("PRIVILEGES")
+	case ShowCreateSequence:
+		ctx.WriteKeyWord("CREATE SEQUENCE ")
+		if err := n.Table.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore ShowStmt.Table")
+		}
+	case ShowCreateProcedure, ShowCreateFunction, ShowCreateTrigger, ShowCreateEvent, ShowCreatePackage, ShowCreatePackageBody:
+		ctx.WriteKeyWord("CREATE ")
+		switch n.Tp {
+		case ShowCreateProcedure:
+			ctx.WriteKeyWord("PROCEDURE ")
+		case ShowCreateFunction:
+			ctx.WriteKeyWord("FUNCTION ")
+		case ShowCreateTrigger:
+			ctx.WriteKeyWord("TRIGGER ")
+		case ShowCreateEvent:
+			ctx.WriteKeyWord("EVENT ")
+		case ShowCreatePackage:
+			ctx.WriteKeyWord("PACKAGE ")
+		case ShowCreatePackageBody:
+			ctx.WriteKeyWord("PACKAGE BODY ")
+		}
+		n.Object.Restore(ctx)
+	case ShowProcedureCode, ShowFunctionCode:
+		if n.Tp == ShowProcedureCode {
+			ctx.WriteKeyWord("PROCEDURE CODE ")
+		} else {
+			ctx.WriteKeyWord("FUNCTION CODE ")
+		}
+		n.Object.Restore(ctx)
+	case ShowEngineStatus, ShowEngineMutex:
+		ctx.WriteKeyWord("ENGINE ")
+		if n.Engine == "" {
+			ctx.WriteKeyWord("ALL")
+		} else {
+			ctx.WriteName(n.Engine)
+		}
+		if n.Tp == ShowEngineStatus {
+			ctx.WriteKeyWord(" STATUS")
+		} else {
+			ctx.WriteKeyWord(" MUTEX")
+		}
+	case ShowBinaryLogs:
+		ctx.WriteKeyWord("BINARY LOGS")
+	case ShowAuthors:
+		ctx.WriteKeyWord("AUTHORS")
+	case ShowContributors:
+		ctx.WriteKeyWord("CONTRIBUTORS")
 	// ShowTargetFilterable
 	default:
 		switch n.Tp {
@@ -2062,6 +2250,9 @@ func (n *ShowStmt) Restore(ctx *format.RestoreCtx) error {
 		case ShowStatus:
 			restoreGlobalScope()
 			ctx.WriteKeyWord("STATUS")
+		case ShowBindings:
+			restoreGlobalScope()
+			ctx.WriteKeyWord("BINDINGS")
 		case ShowCollation:
 			ctx.WriteKeyWord("COLLATION")
 		case ShowTriggers:
@@ -2069,11 +2260,29 @@ func (n *ShowStmt) Restore(ctx *format.RestoreCtx) error {
 			restoreShowDatabaseNameOpt()
 		case ShowProcedureStatus:
 			ctx.WriteKeyWord("PROCEDURE STATUS")
+		case ShowFunctionStatus:
+			ctx.WriteKeyWord("FUNCTION STATUS")
 		case ShowEvents:
 			ctx.WriteKeyWord("EVENTS")
 			restoreShowDatabaseNameOpt()
 		case ShowPlugins:
 			ctx.WriteKeyWord("PLUGINS")
+		case ShowTableStatistics:
+			ctx.WriteKeyWord("TABLE_STATISTICS")
+		case ShowIndexStatistics:
+			ctx.WriteKeyWord("INDEX_STATISTICS")
+		case ShowUserStatistics:
+			ctx.WriteKeyWord("USER_STATISTICS")
+		case ShowClientStatistics:
+			ctx.WriteKeyWord("CLIENT_STATISTICS")
+		case ShowQueryResponseTime:
+			ctx.WriteKeyWord("QUERY_RESPONSE_TIME")
+		case ShowLocales:
+			ctx.WriteKeyWord("LOCALES")
+		case ShowWsrepStatus:
+			ctx.WriteKeyWord("WSREP_STATUS")
+		case ShowWsrepMembership:
+			ctx.WriteKeyWord("WSREP_MEMBERSHIP")
 		default:
 			return errors.New("Unknown ShowStmt type")
 		}
@@ -2112,7 +2321,7 @@ func (n *ShowStmt) Accept(v Visitor) (Node, bool) {
 	}
 
 	switch n.Tp {
-	case ShowTriggers, ShowProcedureStatus, ShowProcessList, ShowEvents:
+	case ShowTriggers, ShowProcedureStatus, ShowFunctionStatus, ShowProcessList, ShowEvents:
 		// We don't have any data to return for those types,
 		// but visiting Where may cause resolving error, so return here to avoid error.
 		return v.Leave(n)
@@ -186,6 +186,7 @@ func (tc *testDMLSuite) TestTableSourceRestore(c *C) {
 		{"tbl as t", "`tbl` AS `t`"},
 		{"(select * from tbl) as t", "(SELECT * FROM `tbl`) AS `t`"},
 		{"(select * from a union select * from b) as t", "(SELECT * FROM `a` UNION SELECT * FROM `b`) AS `t`"},
+		{"(values (1, 'a'), (2, 'b')) as t(c1, c2)", "(VALUES (1,'a'),(2,'b')) AS `t`(`c1`, `c2`)"},
 	}
 	extractNodeFunc := func(node Node) Node {
 		return node.(*SelectStmt).From.TableRefs.Left
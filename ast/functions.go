@@ -238,6 +238,7 @@ const (
 	FoundRows    = "found_rows"
 	LastInsertId = "last_insert_id"
 	RowCount     = "row_count"
+	RowNum       = "rownum"
 	Schema       = "schema"
 	SessionUser  = "session_user"
 	SystemUser   = "system_user"
@@ -245,9 +246,12 @@ const (
 	Version      = "version"
 
 	// control functions
-	If     = "if"
-	Ifnull = "ifnull"
-	Nullif = "nullif"
+	If           = "if"
+	Ifnull       = "ifnull"
+	Nullif       = "nullif"
+	Nvl          = "nvl"
+	Nvl2         = "nvl2"
+	DecodeOracle = "decode_oracle"
 
 	// miscellaneous functions
 	AnyValue        = "any_value"
@@ -318,6 +322,15 @@ const (
 	JSONDepth         = "json_depth"
 	JSONKeys          = "json_keys"
 	JSONLength        = "json_length"
+
+	// statistics functions
+	DecodeHistogram = "decode_histogram"
+
+	// vector functions
+	VecFromText          = "vec_fromtext"
+	VecToText            = "vec_totext"
+	VecDistanceEuclidean = "vec_distance_euclidean"
+	VecDistanceCosine    = "vec_distance_cosine"
 )
 
 // FuncCallExpr is for function expression.
@@ -623,6 +636,8 @@ const (
 	AggFuncStddevPop = "stddev_pop"
 	// AggFuncStddevSamp is the name of stddev_samp function
 	AggFuncStddevSamp = "stddev_samp"
+	// AggFuncGrouping is the name of grouping function, which is only valid with GROUP BY ... WITH ROLLUP.
+	AggFuncGrouping = "grouping"
 )
 
 // AggregateFuncExpr represents aggregate function expression.
@@ -29,22 +29,32 @@ import (
 
 var (
 	_ StmtNode = &AlterUserStmt{}
+	_ StmtNode = &AnalyzeStmt{}
+	_ StmtNode = &BackupStmt{}
 	_ StmtNode = &BeginStmt{}
 	_ StmtNode = &BinlogStmt{}
 	_ StmtNode = &CommitStmt{}
+	_ StmtNode = &CreateBindingStmt{}
 	_ StmtNode = &CreateUserStmt{}
 	_ StmtNode = &DeallocateStmt{}
 	_ StmtNode = &DoStmt{}
+	_ StmtNode = &DropBindingStmt{}
 	_ StmtNode = &ExecuteStmt{}
 	_ StmtNode = &ExplainStmt{}
 	_ StmtNode = &GrantStmt{}
 	_ StmtNode = &GrantRoleStmt{}
+	_ StmtNode = &GrantProxyStmt{}
+	_ StmtNode = &InstallPluginStmt{}
 	_ StmtNode = &PrepareStmt{}
 	_ StmtNode = &RollbackStmt{}
 	_ StmtNode = &SetPwdStmt{}
 	_ StmtNode = &SetRoleStmt{}
+	_ StmtNode = &RenameUserStmt{}
+	_ StmtNode = &RevokeProxyStmt{}
 	_ StmtNode = &SetDefaultRoleStmt{}
 	_ StmtNode = &SetStmt{}
+	_ StmtNode = &SetStatementStmt{}
+	_ StmtNode = &UninstallPluginStmt{}
 	_ StmtNode = &UseStmt{}
 	_ StmtNode = &PurgeStmt{}
 	_ StmtNode = &FlushStmt{}
@@ -64,8 +74,11 @@ const (
 	RepeatableRead  = "REPEATABLE-READ"
 
 	// Valid formats for explain statement.
-	ExplainFormatROW = "row"
-	ExplainFormatDOT = "dot"
+	ExplainFormatROW         = "row"
+	ExplainFormatDOT         = "dot"
+	ExplainFormatJSON        = "json"
+	ExplainFormatTraditional = "traditional"
+	ExplainFormatTree        = "tree"
 )
 
 var (
@@ -73,6 +86,9 @@ var (
 	ExplainFormats = []string{
 		ExplainFormatROW,
 		ExplainFormatDOT,
+		ExplainFormatJSON,
+		ExplainFormatTraditional,
+		ExplainFormatTree,
 	}
 )
 
@@ -95,11 +111,25 @@ type AuthOption struct {
 	ByAuthString bool
 	AuthString   string
 	HashString   string
-	// TODO: support auth_plugin
+	// Plugins is the IDENTIFIED VIA/WITH plugin list, in the order the server tries them.
+	// The fields above are unused if it isn't empty.
+	Plugins []*AuthPlugin
 }
 
 // Restore implements Node interface.
 func (n *AuthOption) Restore(ctx *format.RestoreCtx) error {
+	if len(n.Plugins) > 0 {
+		ctx.WriteKeyWord("IDENTIFIED VIA ")
+		for i, plugin := range n.Plugins {
+			if i != 0 {
+				ctx.WriteKeyWord(" OR ")
+			}
+			if err := plugin.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore AuthOption.Plugins[%d]", i)
+			}
+		}
+		return nil
+	}
 	ctx.WriteKeyWord("IDENTIFIED BY ")
 	if n.ByAuthString {
 		ctx.WriteString(n.AuthString)
@@ -110,6 +140,44 @@ func (n *AuthOption) Restore(ctx *format.RestoreCtx) error {
 	return nil
 }
 
+// hasSecret reports whether the option carries a password or an authentication string.
+func (n *AuthOption) hasSecret() bool {
+	if len(n.AuthString) > 0 || len(n.HashString) > 0 {
+		return true
+	}
+	for _, plugin := range n.Plugins {
+		if len(plugin.AuthString) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthPlugin is an authentication rule of IDENTIFIED VIA, made of a plugin name and an optional authentication string.
+// See https://mariadb.com/kb/en/create-user/#identified-viawith-authentication_plugin
+type AuthPlugin struct {
+	Name string
+	// ByPassword is true for USING PASSWORD('...'), then AuthString is the plain password which the plugin hashes.
+	// Otherwise AuthString is passed to the plugin as is.
+	ByPassword bool
+	AuthString string
+}
+
+// Restore implements Node interface.
+func (n *AuthPlugin) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteName(n.Name)
+	if n.ByPassword {
+		ctx.WriteKeyWord(" USING PASSWORD")
+		ctx.WritePlain("(")
+		ctx.WriteString(n.AuthString)
+		ctx.WritePlain(")")
+	} else if len(n.AuthString) > 0 {
+		ctx.WriteKeyWord(" USING ")
+		ctx.WriteString(n.AuthString)
+	}
+	return nil
+}
+
 // ExplainForStmt is a statement to provite information about how is SQL statement executeing
 // in connection #ConnectionID
 // See https://dev.mysql.com/doc/refman/5.7/en/explain.html
@@ -118,10 +186,25 @@ type ExplainForStmt struct {
 
 	Format       string
 	ConnectionID uint64
+	// ShowExplain indicates the MariaDB `SHOW EXPLAIN FOR <thread_id>` form.
+	// See https://mariadb.com/kb/en/show-explain/
+	ShowExplain bool
 }
 
 // Restore implements Node interface.
 func (n *ExplainForStmt) Restore(ctx *format.RestoreCtx) error {
+	if n.ShowExplain {
+		ctx.WriteKeyWord("SHOW EXPLAIN ")
+		if n.Format != "" && n.Format != ExplainFormatROW {
+			ctx.WriteKeyWord("FORMAT ")
+			ctx.WritePlain("= ")
+			ctx.WriteString(n.Format)
+			ctx.WritePlain(" ")
+		}
+		ctx.WriteKeyWord("FOR ")
+		ctx.WritePlain(strconv.FormatUint(n.ConnectionID, 10))
+		return nil
+	}
 	ctx.WriteKeyWord("EXPLAIN ")
 	ctx.WriteKeyWord("FORMAT ")
 	ctx.WritePlain("= ")
@@ -149,9 +232,11 @@ func (n *ExplainForStmt) Accept(v Visitor) (Node, bool) {
 type ExplainStmt struct {
 	stmtNode
 
-	Stmt    StmtNode
-	Format  string
-	Analyze bool
+	Stmt       StmtNode
+	Format     string
+	Analyze    bool
+	Extended   bool
+	Partitions bool
 }
 
 // Restore implements Node interface.
@@ -172,6 +257,10 @@ func (n *ExplainStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("EXPLAIN ")
 	if n.Analyze {
 		ctx.WriteKeyWord("ANALYZE ")
+	} else if n.Extended {
+		ctx.WriteKeyWord("EXTENDED ")
+	} else if n.Partitions {
+		ctx.WriteKeyWord("PARTITIONS ")
 	} else {
 		ctx.WriteKeyWord("FORMAT ")
 		ctx.WritePlain("= ")
@@ -199,6 +288,47 @@ func (n *ExplainStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// AnalyzeStmt is the MariaDB ANALYZE statement, which executes the statement and
+// reports the plan together with the execution statistics.
+// It is different from AnalyzeTableStmt, which collects the statistics of tables.
+// See https://mariadb.com/kb/en/analyze-statement/
+type AnalyzeStmt struct {
+	stmtNode
+
+	Stmt   StmtNode
+	Format string
+}
+
+// Restore implements Node interface.
+func (n *AnalyzeStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ANALYZE ")
+	if n.Format != "" && n.Format != ExplainFormatROW {
+		ctx.WriteKeyWord("FORMAT ")
+		ctx.WritePlain("= ")
+		ctx.WriteString(n.Format)
+		ctx.WritePlain(" ")
+	}
+	if err := n.Stmt.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AnalyzeStmt.Stmt")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *AnalyzeStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AnalyzeStmt)
+	node, ok := n.Stmt.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Stmt = node.(StmtNode)
+	return v.Leave(n)
+}
+
 // PrepareStmt is a statement to prepares a SQL statement which contains placeholders,
 // and it is executed with ExecuteStmt and released with DeallocateStmt.
 // See https://dev.mysql.com/doc/refman/5.7/en/prepare.html
@@ -515,8 +645,46 @@ const (
 	FlushTables
 	FlushPrivileges
 	FlushStatus
+	FlushLogs
+	FlushHosts
+	FlushQueryCache
+	FlushUserResources
+	FlushSSL
+)
+
+// LogType is the log type used in FLUSH LOGS statement.
+type LogType int
+
+// Log types.
+const (
+	LogTypeDefault LogType = iota
+	LogTypeBinary
+	LogTypeEngine
+	LogTypeError
+	LogTypeGeneral
+	LogTypeRelay
+	LogTypeSlow
 )
 
+// String implements fmt.Stringer interface.
+func (t LogType) String() string {
+	switch t {
+	case LogTypeBinary:
+		return "BINARY"
+	case LogTypeEngine:
+		return "ENGINE"
+	case LogTypeError:
+		return "ERROR"
+	case LogTypeGeneral:
+		return "GENERAL"
+	case LogTypeRelay:
+		return "RELAY"
+	case LogTypeSlow:
+		return "SLOW"
+	}
+	return ""
+}
+
 // FlushStmt is a statement to flush tables/privileges/optimizer costs and so on.
 type FlushStmt struct {
 	stmtNode
@@ -525,6 +693,8 @@ type FlushStmt struct {
 	NoWriteToBinLog bool
 	Tables          []*TableName // For FlushTableStmt, if Tables is empty, it means flush all tables.
 	ReadLock        bool
+	ForExport       bool
+	LogType         LogType
 }
 
 // Restore implements Node interface.
@@ -549,10 +719,27 @@ func (n *FlushStmt) Restore(ctx *format.RestoreCtx) error {
 		if n.ReadLock {
 			ctx.WriteKeyWord(" WITH READ LOCK")
 		}
+		if n.ForExport {
+			ctx.WriteKeyWord(" FOR EXPORT")
+		}
 	case FlushPrivileges:
 		ctx.WriteKeyWord("PRIVILEGES")
 	case FlushStatus:
 		ctx.WriteKeyWord("STATUS")
+	case FlushLogs:
+		if n.LogType != LogTypeDefault {
+			ctx.WriteKeyWord(n.LogType.String())
+			ctx.WritePlain(" ")
+		}
+		ctx.WriteKeyWord("LOGS")
+	case FlushHosts:
+		ctx.WriteKeyWord("HOSTS")
+	case FlushQueryCache:
+		ctx.WriteKeyWord("QUERY CACHE")
+	case FlushUserResources:
+		ctx.WriteKeyWord("USER_RESOURCES")
+	case FlushSSL:
+		ctx.WriteKeyWord("SSL")
 	default:
 		return errors.New("Unsupported type of FlushTables")
 	}
@@ -578,14 +765,37 @@ type KillStmt struct {
 	// If Query is false, terminates the connection associated with the given ConnectionID, after terminating any statement the connection is executing.
 	Query        bool
 	ConnectionID uint64
+
+	// The following fields are only supported by MariaDB.
+	// See https://mariadb.com/kb/en/kill/
+
+	// Hard indicates KILL HARD, which kills the connection or query at once, KILL SOFT is the default.
+	Hard bool
+	// ByQueryID indicates KILL QUERY ID, ConnectionID is the query id instead of the thread id.
+	ByQueryID bool
+	// User is the user whose connections or queries are all killed, ConnectionID is not used if it is set.
+	User *auth.UserIdentity
 }
 
 // Restore implements Node interface.
 func (n *KillStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("KILL")
+	if n.Hard {
+		ctx.WriteKeyWord(" HARD")
+	}
 	if n.Query {
 		ctx.WriteKeyWord(" QUERY")
 	}
+	if n.User != nil {
+		ctx.WriteKeyWord(" USER ")
+		if err := n.User.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore KillStmt.User")
+		}
+		return nil
+	}
+	if n.ByQueryID {
+		ctx.WriteKeyWord(" ID")
+	}
 	ctx.WritePlainf(" %d", n.ConnectionID)
 	return nil
 }
@@ -638,6 +848,59 @@ func (n *SetStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// SetStatementStmt is the MariaDB statement to set the session variables only for the
+// duration of a single statement, like `SET STATEMENT max_statement_time=5 FOR SELECT ...`.
+// See https://mariadb.com/kb/en/set-statement/
+type SetStatementStmt struct {
+	stmtNode
+	// Variables is the list of variable assignment, they are all session system variables.
+	Variables []*VariableAssignment
+	// Stmt is the statement executed with the variables.
+	Stmt StmtNode
+}
+
+// Restore implements Node interface.
+func (n *SetStatementStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("SET STATEMENT ")
+	for i, v := range n.Variables {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		ctx.WriteName(v.Name)
+		ctx.WritePlain("=")
+		if err := v.Value.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore SetStatementStmt.Variables[%d]", i)
+		}
+	}
+	ctx.WriteKeyWord(" FOR ")
+	if err := n.Stmt.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore SetStatementStmt.Stmt")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *SetStatementStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*SetStatementStmt)
+	for i, val := range n.Variables {
+		node, ok := val.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Variables[i] = node.(*VariableAssignment)
+	}
+	node, ok := n.Stmt.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Stmt = node.(StmtNode)
+	return v.Leave(n)
+}
+
 /*
 // SetCharsetStmt is a statement to assign values to character and collation variables.
 // See https://dev.mysql.com/doc/refman/5.7/en/set-statement.html
@@ -759,7 +1022,10 @@ type SetDefaultRoleStmt struct {
 
 	SetRoleOpt SetRoleStmtType
 	RoleList   []*auth.RoleIdentity
-	UserList   []*auth.UserIdentity
+	// UserList is empty if the statement applies to the current user.
+	UserList []*auth.UserIdentity
+	// ForUser is true for the MariaDB form SET DEFAULT ROLE ... FOR user.
+	ForUser bool
 }
 
 // Restore implements Node interface.
@@ -782,7 +1048,14 @@ func (n *SetDefaultRoleStmt) Restore(ctx *format.RestoreCtx) error {
 			ctx.WritePlain(",")
 		}
 	}
-	ctx.WritePlain(" TO")
+	if len(n.UserList) == 0 {
+		return nil
+	}
+	if n.ForUser {
+		ctx.WriteKeyWord(" FOR")
+	} else {
+		ctx.WriteKeyWord(" TO")
+	}
 	for i, user := range n.UserList {
 		ctx.WritePlain(" ")
 		err := user.Restore(ctx)
@@ -829,13 +1102,7 @@ func (n *UserSpec) Restore(ctx *format.RestoreCtx) error {
 
 // SecurityString formats the UserSpec without password information.
 func (n *UserSpec) SecurityString() string {
-	withPassword := false
-	if opt := n.AuthOpt; opt != nil {
-		if len(opt.AuthString) > 0 || len(opt.HashString) > 0 {
-			withPassword = true
-		}
-	}
-	if withPassword {
+	if n.AuthOpt != nil && n.AuthOpt.hasSecret() {
 		return fmt.Sprintf("{%s password = ***}", n.User)
 	}
 	return n.User.String()
@@ -849,15 +1116,34 @@ func (n *UserSpec) EncodedPassword() (string, bool) {
 	}
 
 	opt := n.AuthOpt
+	if len(opt.Plugins) > 0 {
+		// Only mysql_native_password stores its data in the password column.
+		for _, plugin := range opt.Plugins {
+			if !strings.EqualFold(plugin.Name, mysql.AuthName) {
+				continue
+			}
+			if plugin.ByPassword {
+				return auth.EncodePassword(plugin.AuthString), true
+			}
+			if len(plugin.AuthString) == 0 {
+				return "", true
+			}
+			return encodedHashString(plugin.AuthString)
+		}
+		return "", true
+	}
 	if opt.ByAuthString {
 		return auth.EncodePassword(opt.AuthString), true
 	}
+	return encodedHashString(opt.HashString)
+}
 
+func encodedHashString(hash string) (string, bool) {
 	// Not a legal password string.
-	if len(opt.HashString) != 41 || !strings.HasPrefix(opt.HashString, "*") {
+	if len(hash) != 41 || !strings.HasPrefix(hash, "*") {
 		return "", false
 	}
-	return opt.HashString, true
+	return hash, true
 }
 
 // TODO:
@@ -906,12 +1192,15 @@ const (
 	MaxUpdatesPerHour
 	MaxConnectionsPerHour
 	MaxUserConnections
+	MaxStatementTime
 )
 
 // ResourceOption TODO:
 type ResourceOption struct {
 	Type  int
 	Count int64
+	// Timeout is the value of MAX_STATEMENT_TIME in seconds, which may be fractional.
+	Timeout ValueExpr
 }
 
 // Restore implements Node interface.
@@ -925,6 +1214,12 @@ func (r *ResourceOption) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("MAX_CONNECTIONS_PER_HOUR ")
 	case MaxUserConnections:
 		ctx.WriteKeyWord("MAX_USER_CONNECTIONS ")
+	case MaxStatementTime:
+		ctx.WriteKeyWord("MAX_STATEMENT_TIME ")
+		if err := r.Timeout.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore ResourceOption.Timeout")
+		}
+		return nil
 	default:
 		return errors.Errorf("Unsupported ResourceOption.Type %d", r.Type)
 	}
@@ -958,7 +1253,7 @@ func (p *PasswordOrLockOption) Restore(ctx *format.RestoreCtx) error {
 	case PasswordExpireNever:
 		ctx.WriteKeyWord("PASSWORD EXPIRE NEVER")
 	case PasswordExpireInterval:
-		ctx.WriteKeyWord("PASSWORD EXPIRE NEVER")
+		ctx.WriteKeyWord("PASSWORD EXPIRE INTERVAL")
 		ctx.WritePlainf(" %d", p.Count)
 		ctx.WriteKeyWord(" DAY")
 	case Lock:
@@ -977,19 +1272,26 @@ type CreateUserStmt struct {
 	stmtNode
 
 	IsCreateRole          bool
+	OrReplace             bool
 	IfNotExists           bool
 	Specs                 []*UserSpec
 	TslOptions            []*TslOption
 	ResourceOptions       []*ResourceOption
 	PasswordOrLockOptions []*PasswordOrLockOption
+	// AdminUser is the user or role of CREATE ROLE ... WITH ADMIN, who can grant the new role.
+	AdminUser *auth.UserIdentity
 }
 
 // Restore implements Node interface.
 func (n *CreateUserStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if n.OrReplace {
+		ctx.WriteKeyWord("OR REPLACE ")
+	}
 	if n.IsCreateRole {
-		ctx.WriteKeyWord("CREATE ROLE ")
+		ctx.WriteKeyWord("ROLE ")
 	} else {
-		ctx.WriteKeyWord("CREATE USER ")
+		ctx.WriteKeyWord("USER ")
 	}
 	if n.IfNotExists {
 		ctx.WriteKeyWord("IF NOT EXISTS ")
@@ -1003,37 +1305,51 @@ func (n *CreateUserStmt) Restore(ctx *format.RestoreCtx) error {
 		}
 	}
 
-	tslOptionLen := len(n.TslOptions)
+	if n.AdminUser != nil {
+		ctx.WriteKeyWord(" WITH ADMIN ")
+		if err := n.AdminUser.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore CreateUserStmt.AdminUser")
+		}
+	}
+	if err := restoreTslOptions(ctx, n.TslOptions); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateUserStmt.TslOptions")
+	}
+	if err := restoreResourceOptions(ctx, n.ResourceOptions); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateUserStmt.ResourceOptions")
+	}
+	for i, v := range n.PasswordOrLockOptions {
+		ctx.WritePlain(" ")
+		if err := v.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore CreateUserStmt.PasswordOrLockOptions[%d]", i)
+		}
+	}
+	return nil
+}
 
-	if tslOptionLen != 0 {
+func restoreTslOptions(ctx *format.RestoreCtx, options []*TslOption) error {
+	if len(options) != 0 {
 		ctx.WriteKeyWord(" REQUIRE ")
 	}
-
-	// Restore `tslOptions` reversely to keep order the same with original sql
-	for i := tslOptionLen; i > 0; i-- {
-		if i != tslOptionLen {
+	// Restore `options` reversely to keep order the same with original sql
+	for i := len(options); i > 0; i-- {
+		if i != len(options) {
 			ctx.WriteKeyWord(" AND ")
 		}
-		if err := n.TslOptions[i-1].Restore(ctx); err != nil {
-			return errors.Annotatef(err, "An error occurred while restore CreateUserStmt.TslOptions[%d]", i)
+		if err := options[i-1].Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore TslOptions[%d]", i-1)
 		}
 	}
+	return nil
+}
 
-	if len(n.ResourceOptions) != 0 {
+func restoreResourceOptions(ctx *format.RestoreCtx, options []*ResourceOption) error {
+	if len(options) != 0 {
 		ctx.WriteKeyWord(" WITH")
 	}
-
-	for i, v := range n.ResourceOptions {
-		ctx.WritePlain(" ")
-		if err := v.Restore(ctx); err != nil {
-			return errors.Annotatef(err, "An error occurred while restore CreateUserStmt.ResourceOptions[%d]", i)
-		}
-	}
-
-	for i, v := range n.PasswordOrLockOptions {
+	for i, v := range options {
 		ctx.WritePlain(" ")
 		if err := v.Restore(ctx); err != nil {
-			return errors.Annotatef(err, "An error occurred while restore CreateUserStmt.PasswordOrLockOptions[%d]", i)
+			return errors.Annotatef(err, "An error occurred while restore ResourceOptions[%d]", i)
 		}
 	}
 	return nil
@@ -1065,9 +1381,12 @@ func (n *CreateUserStmt) SecureText() string {
 type AlterUserStmt struct {
 	stmtNode
 
-	IfExists    bool
-	CurrentAuth *AuthOption
-	Specs       []*UserSpec
+	IfExists              bool
+	CurrentAuth           *AuthOption
+	Specs                 []*UserSpec
+	TslOptions            []*TslOption
+	ResourceOptions       []*ResourceOption
+	PasswordOrLockOptions []*PasswordOrLockOption
 }
 
 // Restore implements Node interface.
@@ -1091,6 +1410,18 @@ func (n *AlterUserStmt) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotatef(err, "An error occurred while restore AlterUserStmt.Specs[%d]", i)
 		}
 	}
+	if err := restoreTslOptions(ctx, n.TslOptions); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterUserStmt.TslOptions")
+	}
+	if err := restoreResourceOptions(ctx, n.ResourceOptions); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterUserStmt.ResourceOptions")
+	}
+	for i, v := range n.PasswordOrLockOptions {
+		ctx.WritePlain(" ")
+		if err := v.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore AlterUserStmt.PasswordOrLockOptions[%d]", i)
+		}
+	}
 	return nil
 }
 
@@ -1115,6 +1446,56 @@ func (n *AlterUserStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// RenameUserStmt is a statement to rename user accounts.
+// See https://mariadb.com/kb/en/rename-user/
+type RenameUserStmt struct {
+	stmtNode
+
+	UserToUsers []*UserToUser
+}
+
+// Restore implements Node interface.
+func (n *RenameUserStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("RENAME USER ")
+	for i, v := range n.UserToUsers {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := v.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore RenameUserStmt.UserToUsers[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *RenameUserStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*RenameUserStmt)
+	return v.Leave(n)
+}
+
+// UserToUser represents renaming old user to new user used in RenameUserStmt.
+type UserToUser struct {
+	OldUser *auth.UserIdentity
+	NewUser *auth.UserIdentity
+}
+
+// Restore implements Node interface.
+func (n *UserToUser) Restore(ctx *format.RestoreCtx) error {
+	if err := n.OldUser.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore UserToUser.OldUser")
+	}
+	ctx.WriteKeyWord(" TO ")
+	if err := n.NewUser.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore UserToUser.NewUser")
+	}
+	return nil
+}
+
 // DropUserStmt creates user account.
 // See http://dev.mysql.com/doc/refman/5.7/en/drop-user.html
 type DropUserStmt struct {
@@ -1418,13 +1799,18 @@ func (n *RevokeStmt) Accept(v Visitor) (Node, bool) {
 type RevokeRoleStmt struct {
 	stmtNode
 
-	Roles []*auth.RoleIdentity
-	Users []*auth.UserIdentity
+	// AdminOption is true for REVOKE ADMIN OPTION FOR, which only takes away the right to grant the roles.
+	AdminOption bool
+	Roles       []*auth.RoleIdentity
+	Users       []*auth.UserIdentity
 }
 
 // Restore implements Node interface.
 func (n *RevokeRoleStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("REVOKE ")
+	if n.AdminOption {
+		ctx.WriteKeyWord("ADMIN OPTION FOR ")
+	}
 	for i, role := range n.Roles {
 		if i != 0 {
 			ctx.WritePlain(", ")
@@ -1459,11 +1845,13 @@ func (n *RevokeRoleStmt) Accept(v Visitor) (Node, bool) {
 type GrantStmt struct {
 	stmtNode
 
-	Privs      []*PrivElem
-	ObjectType ObjectTypeType
-	Level      *GrantLevel
-	Users      []*UserSpec
-	WithGrant  bool
+	Privs           []*PrivElem
+	ObjectType      ObjectTypeType
+	Level           *GrantLevel
+	Users           []*UserSpec
+	TslOptions      []*TslOption
+	WithGrant       bool
+	ResourceOptions []*ResourceOption
 }
 
 // Restore implements Node interface.
@@ -1498,8 +1886,19 @@ func (n *GrantStmt) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotatef(err, "An error occurred while restore GrantStmt.Users[%d]", i)
 		}
 	}
+	if err := restoreTslOptions(ctx, n.TslOptions); err != nil {
+		return errors.Annotate(err, "An error occurred while restore GrantStmt.TslOptions")
+	}
 	if n.WithGrant {
 		ctx.WriteKeyWord(" WITH GRANT OPTION")
+		for i, v := range n.ResourceOptions {
+			ctx.WritePlain(" ")
+			if err := v.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore GrantStmt.ResourceOptions[%d]", i)
+			}
+		}
+	} else if err := restoreResourceOptions(ctx, n.ResourceOptions); err != nil {
+		return errors.Annotate(err, "An error occurred while restore GrantStmt.ResourceOptions")
 	}
 	return nil
 }
@@ -1536,8 +1935,9 @@ func (n *GrantStmt) Accept(v Visitor) (Node, bool) {
 type GrantRoleStmt struct {
 	stmtNode
 
-	Roles []*auth.RoleIdentity
-	Users []*auth.UserIdentity
+	Roles     []*auth.RoleIdentity
+	Users     []*auth.UserIdentity
+	WithAdmin bool
 }
 
 // Accept implements Node Accept interface.
@@ -1572,6 +1972,9 @@ func (n *GrantRoleStmt) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotatef(err, "An error occurred while restore GrantStmt.Users[%d]", i)
 		}
 	}
+	if n.WithAdmin {
+		ctx.WriteKeyWord(" WITH ADMIN OPTION")
+	}
 	return nil
 }
 
@@ -1586,6 +1989,83 @@ func (n *GrantRoleStmt) SecureText() string {
 	return text
 }
 
+// GrantProxyStmt is the struct for GRANT PROXY statement.
+// See https://mariadb.com/kb/en/grant/#proxy-privileges
+type GrantProxyStmt struct {
+	stmtNode
+
+	LocalUser     *auth.UserIdentity
+	ExternalUsers []*auth.UserIdentity
+	WithGrant     bool
+}
+
+// Restore implements Node interface.
+func (n *GrantProxyStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("GRANT PROXY ON ")
+	if err := n.LocalUser.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore GrantProxyStmt.LocalUser")
+	}
+	ctx.WriteKeyWord(" TO ")
+	for i, v := range n.ExternalUsers {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := v.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore GrantProxyStmt.ExternalUsers[%d]", i)
+		}
+	}
+	if n.WithGrant {
+		ctx.WriteKeyWord(" WITH GRANT OPTION")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *GrantProxyStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*GrantProxyStmt)
+	return v.Leave(n)
+}
+
+// RevokeProxyStmt is the struct for REVOKE PROXY statement.
+type RevokeProxyStmt struct {
+	stmtNode
+
+	LocalUser     *auth.UserIdentity
+	ExternalUsers []*auth.UserIdentity
+}
+
+// Restore implements Node interface.
+func (n *RevokeProxyStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("REVOKE PROXY ON ")
+	if err := n.LocalUser.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore RevokeProxyStmt.LocalUser")
+	}
+	ctx.WriteKeyWord(" FROM ")
+	for i, v := range n.ExternalUsers {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := v.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore RevokeProxyStmt.ExternalUsers[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *RevokeProxyStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*RevokeProxyStmt)
+	return v.Leave(n)
+}
+
 // LockTableStmt TODO:
 type LockTableStmt struct {
 	stmtNode
@@ -1626,6 +2106,92 @@ func (n *UnlockTableStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// BackupStmtType is the type for BACKUP statement.
+type BackupStmtType int
+
+// Backup statement types.
+const (
+	BackupStmtStage BackupStmtType = iota
+	BackupStmtLock
+	BackupStmtUnlock
+)
+
+// BackupStage is the stage used in BACKUP STAGE statement.
+type BackupStage int
+
+// Backup stages.
+const (
+	BackupStageStart BackupStage = iota
+	BackupStageFlush
+	BackupStageBlockDDL
+	BackupStageBlockCommit
+	BackupStageEnd
+)
+
+// String implements fmt.Stringer interface.
+func (s BackupStage) String() string {
+	switch s {
+	case BackupStageStart:
+		return "START"
+	case BackupStageFlush:
+		return "FLUSH"
+	case BackupStageBlockDDL:
+		return "BLOCK_DDL"
+	case BackupStageBlockCommit:
+		return "BLOCK_COMMIT"
+	case BackupStageEnd:
+		return "END"
+	}
+	return ""
+}
+
+// BackupStmt is a statement to control the stages of a backup, or to lock a table for backup.
+// See https://mariadb.com/kb/en/backup-stage/
+// See https://mariadb.com/kb/en/backup-lock/
+type BackupStmt struct {
+	stmtNode
+
+	Tp    BackupStmtType
+	Stage BackupStage
+	Table *TableName
+}
+
+// Restore implements Node interface.
+func (n *BackupStmt) Restore(ctx *format.RestoreCtx) error {
+	switch n.Tp {
+	case BackupStmtStage:
+		ctx.WriteKeyWord("BACKUP STAGE ")
+		ctx.WriteKeyWord(n.Stage.String())
+	case BackupStmtLock:
+		ctx.WriteKeyWord("BACKUP LOCK ")
+		if err := n.Table.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore BackupStmt.Table")
+		}
+	case BackupStmtUnlock:
+		ctx.WriteKeyWord("BACKUP UNLOCK")
+	default:
+		return errors.New("Unsupported type of BackupStmt")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *BackupStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*BackupStmt)
+	if n.Table != nil {
+		node, ok := n.Table.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Table = node.(*TableName)
+	}
+	return v.Leave(n)
+}
+
 // PurgeStmt TODO:
 type PurgeStmt struct {
 	stmtNode
@@ -1646,6 +2212,172 @@ func (n *PurgeStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// InstallPluginStmt is a statement to install a plugin or all plugins of a library.
+// If PluginName is empty, it is an INSTALL SONAME statement.
+// See https://mariadb.com/kb/en/install-plugin/
+type InstallPluginStmt struct {
+	stmtNode
+
+	// IfNotExists is only supported by MariaDB.
+	IfNotExists bool
+	PluginName  string
+	Soname      string
+}
+
+// Restore implements Node interface.
+func (n *InstallPluginStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("INSTALL ")
+	if n.PluginName != "" {
+		ctx.WriteKeyWord("PLUGIN ")
+		if n.IfNotExists {
+			ctx.WriteKeyWord("IF NOT EXISTS ")
+		}
+		ctx.WriteName(n.PluginName)
+		ctx.WritePlain(" ")
+	}
+	ctx.WriteKeyWord("SONAME ")
+	ctx.WriteString(n.Soname)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *InstallPluginStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*InstallPluginStmt)
+	return v.Leave(n)
+}
+
+// UninstallPluginStmt is a statement to uninstall a plugin or all plugins of a library.
+// If PluginName is empty, it is an UNINSTALL SONAME statement.
+// See https://mariadb.com/kb/en/uninstall-plugin/
+type UninstallPluginStmt struct {
+	stmtNode
+
+	// IfExists is only supported by MariaDB.
+	IfExists   bool
+	PluginName string
+	Soname     string
+}
+
+// Restore implements Node interface.
+func (n *UninstallPluginStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("UNINSTALL ")
+	if n.PluginName != "" {
+		ctx.WriteKeyWord("PLUGIN ")
+		if n.IfExists {
+			ctx.WriteKeyWord("IF EXISTS ")
+		}
+		ctx.WriteName(n.PluginName)
+		return nil
+	}
+	ctx.WriteKeyWord("SONAME ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	ctx.WriteString(n.Soname)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *UninstallPluginStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*UninstallPluginStmt)
+	return v.Leave(n)
+}
+
+// CreateBindingStmt is a statement to bind an execution plan, expressed as optimizer hints, to a query.
+// The original and the hinted queries must have the same digest once the hints are removed.
+type CreateBindingStmt struct {
+	stmtNode
+
+	GlobalScope bool
+	OriginSel   *SelectStmt
+	HintedSel   *SelectStmt
+}
+
+// Restore implements Node interface.
+func (n *CreateBindingStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if n.GlobalScope {
+		ctx.WriteKeyWord("GLOBAL ")
+	} else {
+		ctx.WriteKeyWord("SESSION ")
+	}
+	ctx.WriteKeyWord("BINDING FOR ")
+	if err := n.OriginSel.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateBindingStmt.OriginSel")
+	}
+	ctx.WriteKeyWord(" USING ")
+	if err := n.HintedSel.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateBindingStmt.HintedSel")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateBindingStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateBindingStmt)
+	origNode, ok := n.OriginSel.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.OriginSel = origNode.(*SelectStmt)
+	hintedNode, ok := n.HintedSel.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.HintedSel = hintedNode.(*SelectStmt)
+	return v.Leave(n)
+}
+
+// DropBindingStmt is a statement to drop the plan binding of a query.
+type DropBindingStmt struct {
+	stmtNode
+
+	GlobalScope bool
+	OriginSel   *SelectStmt
+}
+
+// Restore implements Node interface.
+func (n *DropBindingStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP ")
+	if n.GlobalScope {
+		ctx.WriteKeyWord("GLOBAL ")
+	} else {
+		ctx.WriteKeyWord("SESSION ")
+	}
+	ctx.WriteKeyWord("BINDING FOR ")
+	if err := n.OriginSel.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DropBindingStmt.OriginSel")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropBindingStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropBindingStmt)
+	origNode, ok := n.OriginSel.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.OriginSel = origNode.(*SelectStmt)
+	return v.Leave(n)
+}
+
 // Ident is the table identifier composed of schema name and table name.
 type Ident struct {
 	Schema model.CIStr
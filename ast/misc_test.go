@@ -56,6 +56,7 @@ func (ts *testMiscSuite) TestMiscVisitorCover(c *C) {
 		&DoStmt{},
 		&ExecuteStmt{UsingVars: []ExprNode{valueExpr}},
 		&ExplainStmt{Stmt: &ShowStmt{}},
+		&AnalyzeStmt{Stmt: &ShowStmt{}},
 		&GrantStmt{},
 		&PrepareStmt{SQLVar: &VariableExpr{Value: valueExpr}},
 		&RollbackStmt{},
@@ -72,10 +73,19 @@ func (ts *testMiscSuite) TestMiscVisitorCover(c *C) {
 			},
 		},
 		&FlushStmt{},
+		&BackupStmt{},
+		&BackupStmt{Tp: BackupStmtLock, Table: &TableName{}},
 		&PrivElem{},
 		&VariableAssignment{Value: valueExpr},
 		&KillStmt{},
+		&InstallPluginStmt{},
+		&UninstallPluginStmt{},
 		&DropStatsStmt{Table: &TableName{}},
+		&RenameUserStmt{},
+		&GrantProxyStmt{},
+		&RevokeProxyStmt{},
+		&CreateBindingStmt{OriginSel: &SelectStmt{}, HintedSel: &SelectStmt{}},
+		&DropBindingStmt{OriginSel: &SelectStmt{}},
 	}
 
 	for _, v := range stmts {
@@ -133,6 +143,8 @@ func (ts *testMiscSuite) TestSensitiveStatement(c *C) {
 		&CreateUserStmt{},
 		&AlterUserStmt{},
 		&GrantStmt{},
+		&CreateServerStmt{},
+		&AlterServerStmt{},
 	}
 	for i, stmt := range positive {
 		_, ok := stmt.(SensitiveStmtNode)
@@ -151,6 +163,7 @@ func (ts *testMiscSuite) TestSensitiveStatement(c *C) {
 		&DropTableStmt{},
 		&RenameTableStmt{},
 		&TruncateTableStmt{},
+		&DropServerStmt{},
 	}
 	for _, stmt := range negative {
 		_, ok := stmt.(SensitiveStmtNode)
@@ -158,6 +171,24 @@ func (ts *testMiscSuite) TestSensitiveStatement(c *C) {
 	}
 }
 
+func (ts *testMiscSuite) TestServerSecureText(c *C) {
+	options := []*ServerOption{
+		{Tp: ServerOptionUser, StrValue: "u"},
+		{Tp: ServerOptionPassword, StrValue: "secret"},
+		{Tp: ServerOptionPort, UintValue: 3306},
+	}
+	create := &CreateServerStmt{Name: "s", Wrapper: "mysql", Options: options}
+	c.Assert(create.SecureText(), Equals, "CREATE SERVER `s` FOREIGN DATA WRAPPER `mysql` OPTIONS (USER 'u', PASSWORD ***, PORT 3306)")
+	alter := &AlterServerStmt{Name: "s", Options: options}
+	c.Assert(alter.SecureText(), Equals, "ALTER SERVER `s` OPTIONS (USER 'u', PASSWORD ***, PORT 3306)")
+
+	// An invalid option must not leave a partial text behind.
+	options = append(options, &ServerOption{Tp: -1})
+	create.Options, alter.Options = options, options
+	c.Assert(create.SecureText(), Equals, "create server s")
+	c.Assert(alter.SecureText(), Equals, "alter server s")
+}
+
 func (ts *testMiscSuite) TestUserSpec(c *C) {
 	hashString := "*3D56A309CD04FA2EEF181462E59011F075C89548"
 	u := UserSpec{
@@ -187,4 +218,28 @@ func (ts *testMiscSuite) TestUserSpec(c *C) {
 	pwd, ok = u.EncodedPassword()
 	c.Assert(ok, IsTrue)
 	c.Assert(pwd, Equals, "")
+
+	u.AuthOpt = &AuthOption{
+		Plugins: []*AuthPlugin{
+			{Name: "unix_socket"},
+			{Name: "mysql_native_password", ByPassword: true, AuthString: "xxx"},
+		},
+	}
+	pwd, ok = u.EncodedPassword()
+	c.Assert(ok, IsTrue)
+	c.Assert(pwd, Equals, hashString)
+	c.Assert(u.SecurityString(), Equals, "{test@ password = ***}")
+
+	u.AuthOpt.Plugins[1] = &AuthPlugin{Name: "mysql_native_password", AuthString: "not-good-password-format"}
+	pwd, ok = u.EncodedPassword()
+	c.Assert(ok, IsFalse)
+
+	u.AuthOpt.Plugins = []*AuthPlugin{{Name: "pam", AuthString: "mariadb"}}
+	pwd, ok = u.EncodedPassword()
+	c.Assert(ok, IsTrue)
+	c.Assert(pwd, Equals, "")
+	c.Assert(u.SecurityString(), Equals, "{test@ password = ***}")
+
+	u.AuthOpt.Plugins = []*AuthPlugin{{Name: "unix_socket"}}
+	c.Assert(u.SecurityString(), Equals, "test@")
 }
@@ -0,0 +1,601 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"github.com/pingcap/errors"
+
+	"github.com/mia0x75/parser/format"
+	"github.com/mia0x75/parser/model"
+	"github.com/mia0x75/parser/types"
+)
+
+var (
+	_ DDLNode = &CreatePackageStmt{}
+	_ DDLNode = &CreateProcedureStmt{}
+
+	_ StmtNode = &ProcedureAssignmentStmt{}
+	_ StmtNode = &ProcedureBlock{}
+	_ StmtNode = &ProcedureNullStmt{}
+
+	_ Node = &PackageProcedure{}
+	_ Node = &ProcedureDecl{}
+	_ Node = &ProcedureExceptionHandler{}
+	_ Node = &ProcedureParam{}
+	_ Node = &VariableType{}
+)
+
+// The stored programs below are written in the PL/SQL syntax of sql_mode=ORACLE.
+// See https://mariadb.com/kb/en/sql_modeoracle/
+
+// VariableType is the data type of a stored program variable or parameter.
+// Exactly one of Tp, AnchorColumn and AnchorTable is set.
+type VariableType struct {
+	node
+
+	Tp *types.FieldType
+	// AnchorColumn is the column or variable of a column%TYPE anchored type.
+	AnchorColumn *ColumnName
+	// AnchorTable is the table of a table%ROWTYPE anchored type.
+	AnchorTable *TableName
+}
+
+// Restore implements Node interface.
+func (n *VariableType) Restore(ctx *format.RestoreCtx) error {
+	switch {
+	case n.AnchorColumn != nil:
+		if err := n.AnchorColumn.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore VariableType.AnchorColumn")
+		}
+		ctx.WriteKeyWord("%TYPE")
+	case n.AnchorTable != nil:
+		if err := n.AnchorTable.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore VariableType.AnchorTable")
+		}
+		ctx.WriteKeyWord("%ROWTYPE")
+	default:
+		if err := n.Tp.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore VariableType.Tp")
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *VariableType) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*VariableType)
+	if n.AnchorColumn != nil {
+		node, ok := n.AnchorColumn.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.AnchorColumn = node.(*ColumnName)
+	}
+	if n.AnchorTable != nil {
+		node, ok := n.AnchorTable.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.AnchorTable = node.(*TableName)
+	}
+	return v.Leave(n)
+}
+
+// ProcedureParamMode is the mode of a stored procedure parameter.
+type ProcedureParamMode int
+
+// Procedure parameter modes.
+const (
+	ProcedureParamIn ProcedureParamMode = iota
+	ProcedureParamOut
+	ProcedureParamInOut
+)
+
+// ProcedureParam is a parameter of a stored procedure.
+type ProcedureParam struct {
+	node
+
+	Name model.CIStr
+	Mode ProcedureParamMode
+	Tp   *VariableType
+}
+
+// Restore implements Node interface.
+func (n *ProcedureParam) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteName(n.Name.O)
+	switch n.Mode {
+	case ProcedureParamIn:
+		ctx.WriteKeyWord(" IN ")
+	case ProcedureParamOut:
+		ctx.WriteKeyWord(" OUT ")
+	case ProcedureParamInOut:
+		ctx.WriteKeyWord(" IN OUT ")
+	default:
+		return errors.Errorf("invalid ProcedureParamMode: %d", n.Mode)
+	}
+	if err := n.Tp.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ProcedureParam.Tp")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ProcedureParam) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ProcedureParam)
+	node, ok := n.Tp.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Tp = node.(*VariableType)
+	return v.Leave(n)
+}
+
+// ProcedureDecl is a variable declaration of a stored program.
+type ProcedureDecl struct {
+	node
+
+	Name    model.CIStr
+	Tp      *VariableType
+	Default ExprNode
+}
+
+// Restore implements Node interface.
+func (n *ProcedureDecl) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteName(n.Name.O)
+	ctx.WritePlain(" ")
+	if err := n.Tp.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ProcedureDecl.Tp")
+	}
+	if n.Default != nil {
+		ctx.WritePlain(" := ")
+		if err := n.Default.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore ProcedureDecl.Default")
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ProcedureDecl) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ProcedureDecl)
+	node, ok := n.Tp.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Tp = node.(*VariableType)
+	if n.Default != nil {
+		node, ok = n.Default.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Default = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
+
+// ProcedureExceptionHandler is a WHEN ... THEN handler of the EXCEPTION section of a block.
+// Names is empty for WHEN OTHERS, which handles any exception.
+type ProcedureExceptionHandler struct {
+	node
+
+	Names []model.CIStr
+	Stmts []StmtNode
+}
+
+// Restore implements Node interface.
+func (n *ProcedureExceptionHandler) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("WHEN ")
+	if len(n.Names) == 0 {
+		ctx.WriteKeyWord("OTHERS")
+	}
+	for i, name := range n.Names {
+		if i != 0 {
+			ctx.WriteKeyWord(" OR ")
+		}
+		ctx.WriteName(name.O)
+	}
+	ctx.WriteKeyWord(" THEN")
+	if err := restoreProcedureStmts(ctx, n.Stmts); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ProcedureExceptionHandler.Stmts")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ProcedureExceptionHandler) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ProcedureExceptionHandler)
+	if !acceptProcedureStmts(v, n.Stmts) {
+		return n, false
+	}
+	return v.Leave(n)
+}
+
+// ProcedureBlock is a block of a stored program: the optional declarations, the
+// statements between BEGIN and END and the optional EXCEPTION section.
+// A nested block starts with DECLARE if it has declarations; the declarations of
+// the outermost block of a procedure follow its AS or IS instead.
+type ProcedureBlock struct {
+	stmtNode
+
+	Decls    []*ProcedureDecl
+	Stmts    []StmtNode
+	Handlers []*ProcedureExceptionHandler
+}
+
+// Restore implements Node interface.
+func (n *ProcedureBlock) Restore(ctx *format.RestoreCtx) error {
+	if len(n.Decls) != 0 {
+		ctx.WriteKeyWord("DECLARE ")
+	}
+	return n.restore(ctx)
+}
+
+// restore restores the block without the DECLARE keyword.
+func (n *ProcedureBlock) restore(ctx *format.RestoreCtx) error {
+	if err := restoreProcedureDecls(ctx, n.Decls); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ProcedureBlock.Decls")
+	}
+	ctx.WriteKeyWord("BEGIN")
+	if err := restoreProcedureStmts(ctx, n.Stmts); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ProcedureBlock.Stmts")
+	}
+	if len(n.Handlers) != 0 {
+		ctx.WriteKeyWord(" EXCEPTION")
+		for i, handler := range n.Handlers {
+			ctx.WritePlain(" ")
+			if err := handler.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore ProcedureBlock.Handlers[%d]", i)
+			}
+		}
+	}
+	ctx.WriteKeyWord(" END")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ProcedureBlock) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ProcedureBlock)
+	if !acceptProcedureDecls(v, n.Decls) || !acceptProcedureStmts(v, n.Stmts) {
+		return n, false
+	}
+	for i, handler := range n.Handlers {
+		node, ok := handler.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Handlers[i] = node.(*ProcedureExceptionHandler)
+	}
+	return v.Leave(n)
+}
+
+// ProcedureAssignmentStmt is a `variable := expression` statement of a stored program.
+type ProcedureAssignmentStmt struct {
+	stmtNode
+
+	Name model.CIStr
+	Expr ExprNode
+}
+
+// Restore implements Node interface.
+func (n *ProcedureAssignmentStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteName(n.Name.O)
+	ctx.WritePlain(" := ")
+	if err := n.Expr.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ProcedureAssignmentStmt.Expr")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ProcedureAssignmentStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ProcedureAssignmentStmt)
+	node, ok := n.Expr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Expr = node.(ExprNode)
+	return v.Leave(n)
+}
+
+// ProcedureNullStmt is the NULL statement of a stored program, which does nothing.
+type ProcedureNullStmt struct {
+	stmtNode
+}
+
+// Restore implements Node interface.
+func (n *ProcedureNullStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("NULL")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ProcedureNullStmt) Accept(v Visitor) (Node, bool) {
+	newNode, _ := v.Enter(n)
+	return v.Leave(newNode)
+}
+
+// ObjectName is the name of a stored routine, package, trigger or event, which may be
+// qualified by its schema.
+type ObjectName struct {
+	Schema model.CIStr
+	Name   model.CIStr
+}
+
+// Restore restores the name.
+func (n *ObjectName) Restore(ctx *format.RestoreCtx) {
+	if n.Schema.String() != "" {
+		ctx.WriteName(n.Schema.String())
+		ctx.WritePlain(".")
+	}
+	ctx.WriteName(n.Name.String())
+}
+
+// restoreAsOrIs writes the AS or IS which starts the body of a stored program.
+func restoreAsOrIs(ctx *format.RestoreCtx, useIs bool) {
+	if useIs {
+		ctx.WriteKeyWord(" IS ")
+	} else {
+		ctx.WriteKeyWord(" AS ")
+	}
+}
+
+// CreateProcedureStmt is a statement to create a stored procedure.
+// See https://mariadb.com/kb/en/create-procedure/
+type CreateProcedureStmt struct {
+	ddlNode
+
+	OrReplace bool
+	Name      *ObjectName
+	Params    []*ProcedureParam
+	UseIs     bool // IS is written instead of AS.
+	Block     *ProcedureBlock
+}
+
+// Restore implements Node interface.
+func (n *CreateProcedureStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if n.OrReplace {
+		ctx.WriteKeyWord("OR REPLACE ")
+	}
+	ctx.WriteKeyWord("PROCEDURE ")
+	n.Name.Restore(ctx)
+	if err := restoreProcedureParams(ctx, n.Params); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateProcedureStmt.Params")
+	}
+	restoreAsOrIs(ctx, n.UseIs)
+	if err := n.Block.restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateProcedureStmt.Block")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateProcedureStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateProcedureStmt)
+	if !acceptProcedureParams(v, n.Params) {
+		return n, false
+	}
+	node, ok := n.Block.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Block = node.(*ProcedureBlock)
+	return v.Leave(n)
+}
+
+// PackageProcedure is a procedure of a package. Block is nil for the procedure
+// declarations of a package specification and the forward declarations of a package body.
+type PackageProcedure struct {
+	node
+
+	Name   model.CIStr
+	Params []*ProcedureParam
+	UseIs  bool // IS is written instead of AS.
+	Block  *ProcedureBlock
+}
+
+// Restore implements Node interface.
+func (n *PackageProcedure) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("PROCEDURE ")
+	ctx.WriteName(n.Name.O)
+	if err := restoreProcedureParams(ctx, n.Params); err != nil {
+		return errors.Annotate(err, "An error occurred while restore PackageProcedure.Params")
+	}
+	if n.Block != nil {
+		restoreAsOrIs(ctx, n.UseIs)
+		if err := n.Block.restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore PackageProcedure.Block")
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *PackageProcedure) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*PackageProcedure)
+	if !acceptProcedureParams(v, n.Params) {
+		return n, false
+	}
+	if n.Block != nil {
+		node, ok := n.Block.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Block = node.(*ProcedureBlock)
+	}
+	return v.Leave(n)
+}
+
+// CreatePackageStmt is a statement to create a package specification, or a package body if IsBody is set.
+// See https://mariadb.com/kb/en/create-package/ and https://mariadb.com/kb/en/create-package-body/
+type CreatePackageStmt struct {
+	ddlNode
+
+	OrReplace  bool
+	IsBody     bool
+	Name       *ObjectName
+	UseIs      bool // IS is written instead of AS.
+	Decls      []*ProcedureDecl
+	Procedures []*PackageProcedure
+}
+
+// Restore implements Node interface.
+func (n *CreatePackageStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if n.OrReplace {
+		ctx.WriteKeyWord("OR REPLACE ")
+	}
+	ctx.WriteKeyWord("PACKAGE ")
+	if n.IsBody {
+		ctx.WriteKeyWord("BODY ")
+	}
+	n.Name.Restore(ctx)
+	restoreAsOrIs(ctx, n.UseIs)
+	if err := restoreProcedureDecls(ctx, n.Decls); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreatePackageStmt.Decls")
+	}
+	for i, procedure := range n.Procedures {
+		if err := procedure.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore CreatePackageStmt.Procedures[%d]", i)
+		}
+		ctx.WritePlain("; ")
+	}
+	ctx.WriteKeyWord("END")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreatePackageStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreatePackageStmt)
+	if !acceptProcedureDecls(v, n.Decls) {
+		return n, false
+	}
+	for i, procedure := range n.Procedures {
+		node, ok := procedure.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Procedures[i] = node.(*PackageProcedure)
+	}
+	return v.Leave(n)
+}
+
+func restoreProcedureParams(ctx *format.RestoreCtx, params []*ProcedureParam) error {
+	if len(params) == 0 {
+		return nil
+	}
+	ctx.WritePlain("(")
+	for i, param := range params {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := param.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore ProcedureParam[%d]", i)
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// restoreProcedureDecls restores each declaration followed by a semicolon and a space.
+func restoreProcedureDecls(ctx *format.RestoreCtx, decls []*ProcedureDecl) error {
+	for i, decl := range decls {
+		if err := decl.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore ProcedureDecl[%d]", i)
+		}
+		ctx.WritePlain("; ")
+	}
+	return nil
+}
+
+// restoreProcedureStmts restores each statement preceded by a space and followed by a semicolon.
+func restoreProcedureStmts(ctx *format.RestoreCtx, stmts []StmtNode) error {
+	for i, stmt := range stmts {
+		ctx.WritePlain(" ")
+		if err := stmt.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore Stmts[%d]", i)
+		}
+		ctx.WritePlain(";")
+	}
+	return nil
+}
+
+func acceptProcedureParams(v Visitor, params []*ProcedureParam) bool {
+	for i, param := range params {
+		node, ok := param.Accept(v)
+		if !ok {
+			return false
+		}
+		params[i] = node.(*ProcedureParam)
+	}
+	return true
+}
+
+func acceptProcedureDecls(v Visitor, decls []*ProcedureDecl) bool {
+	for i, decl := range decls {
+		node, ok := decl.Accept(v)
+		if !ok {
+			return false
+		}
+		decls[i] = node.(*ProcedureDecl)
+	}
+	return true
+}
+
+func acceptProcedureStmts(v Visitor, stmts []StmtNode) bool {
+	for i, stmt := range stmts {
+		node, ok := stmt.Accept(v)
+		if !ok {
+			return false
+		}
+		stmts[i] = node.(StmtNode)
+	}
+	return true
+}
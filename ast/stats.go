@@ -27,31 +27,66 @@ var (
 )
 
 // AnalyzeTableStmt is used to create table statistics.
+// PartitionNames, IndexNames and MaxNumBuckets are only for the TiDB compatible form.
 type AnalyzeTableStmt struct {
 	stmtNode
 
-	TableNames     []*TableName
-	PartitionNames []model.CIStr
-	IndexNames     []model.CIStr
-	MaxNumBuckets  uint64
+	NoWriteToBinLog bool
+	TableNames      []*TableName
+	PartitionNames  []model.CIStr
+	IndexNames      []model.CIStr
+	MaxNumBuckets   uint64
 
 	// IndexFlag is true when we only analyze indices for a table.
 	IndexFlag bool
+
+	// PersistentFor is the MariaDB PERSISTENT FOR clause.
+	PersistentFor *AnalyzePersistentFor
+
+	// AlterTable is true for the ALTER TABLE ... ANALYZE PARTITION form.
+	AlterTable bool
+}
+
+// AnalyzePersistentFor is used to collect engine-independent statistics.
+// Unless All or AllColumns is set, Columns lists the columns, and an empty one means none of them.
+// Indexes is the same.
+// See https://mariadb.com/kb/en/analyze-table/
+type AnalyzePersistentFor struct {
+	// All is true for PERSISTENT FOR ALL.
+	All bool
+
+	AllColumns bool
+	Columns    []model.CIStr
+	AllIndexes bool
+	Indexes    []model.CIStr
 }
 
 // Restore implements Node interface.
 func (n *AnalyzeTableStmt) Restore(ctx *format.RestoreCtx) error {
-	ctx.WriteKeyWord("ANALYZE TABLE ")
-	for i, table := range n.TableNames {
-		if i != 0 {
-			ctx.WritePlain(",")
+	if n.AlterTable {
+		// Analyzing partitions is written as ALTER TABLE in MariaDB.
+		ctx.WriteKeyWord("ALTER TABLE ")
+		if err := n.TableNames[0].Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AnalyzeTableStmt.TableNames[0]")
 		}
-		if err := table.Restore(ctx); err != nil {
-			return errors.Annotatef(err, "An error occurred while restore AnalyzeTableStmt.TableNames[%d]", i)
+		ctx.WriteKeyWord(" ANALYZE PARTITION ")
+	} else {
+		ctx.WriteKeyWord("ANALYZE ")
+		if n.NoWriteToBinLog {
+			ctx.WriteKeyWord("NO_WRITE_TO_BINLOG ")
+		}
+		ctx.WriteKeyWord("TABLE ")
+		for i, table := range n.TableNames {
+			if i != 0 {
+				ctx.WritePlain(",")
+			}
+			if err := table.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore AnalyzeTableStmt.TableNames[%d]", i)
+			}
+		}
+		if len(n.PartitionNames) != 0 {
+			ctx.WriteKeyWord(" PARTITION ")
 		}
-	}
-	if len(n.PartitionNames) != 0 {
-		ctx.WriteKeyWord(" PARTITION ")
 	}
 	for i, partition := range n.PartitionNames {
 		if i != 0 {
@@ -75,9 +110,35 @@ func (n *AnalyzeTableStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlainf("%d", n.MaxNumBuckets)
 		ctx.WriteKeyWord(" BUCKETS")
 	}
+	if n.PersistentFor != nil {
+		ctx.WriteKeyWord(" PERSISTENT FOR ")
+		if n.PersistentFor.All {
+			ctx.WriteKeyWord("ALL")
+			return nil
+		}
+		ctx.WriteKeyWord("COLUMNS ")
+		restoreNameList(ctx, n.PersistentFor.AllColumns, n.PersistentFor.Columns)
+		ctx.WriteKeyWord(" INDEXES ")
+		restoreNameList(ctx, n.PersistentFor.AllIndexes, n.PersistentFor.Indexes)
+	}
 	return nil
 }
 
+func restoreNameList(ctx *format.RestoreCtx, all bool, names []model.CIStr) {
+	if all {
+		ctx.WriteKeyWord("ALL")
+		return
+	}
+	ctx.WritePlain("(")
+	for i, name := range names {
+		if i != 0 {
+			ctx.WritePlain(",")
+		}
+		ctx.WriteName(name.O)
+	}
+	ctx.WritePlain(")")
+}
+
 // Accept implements Node Accept interface.
 func (n *AnalyzeTableStmt) Accept(v Visitor) (Node, bool) {
 	newNode, skipChildren := v.Enter(n)
@@ -13,6 +13,53 @@
 
 package ast
 
+import (
+	"github.com/pingcap/errors"
+
+	"github.com/mia0x75/parser/format"
+)
+
+// ExecutableComment is a comment whose content is executed by the server, either
+// "/*!NNNNN ... */" or "/*M!NNNNNN ... */", which only MariaDB executes.
+// See https://mariadb.com/kb/en/comment-syntax/
+type ExecutableComment struct {
+	// MariaDB is true for the "/*M!" form.
+	MariaDB bool
+	// Version is the lowest server version executing the content, in the MYSQL_VERSION_ID
+	// format such as 50700 for 5.7.0 or 100500 for 10.5.0. It is 0 if the comment has none.
+	Version int
+}
+
+// ExecutableCommentOf returns the executable comment stmt is written in, or nil.
+func ExecutableCommentOf(stmt StmtNode) *ExecutableComment {
+	if node, ok := stmt.(ExecutableCommentNode); ok {
+		return node.ExecutableComment()
+	}
+	return nil
+}
+
+// RestoreStmt restores stmt, re-emitting the executable comment it was written in, if any.
+func RestoreStmt(ctx *format.RestoreCtx, stmt StmtNode) error {
+	comment := ExecutableCommentOf(stmt)
+	if comment == nil {
+		return stmt.Restore(ctx)
+	}
+	if comment.MariaDB {
+		ctx.WritePlain("/*M!")
+	} else {
+		ctx.WritePlain("/*!")
+	}
+	if comment.Version != 0 {
+		ctx.WritePlainf("%d", comment.Version)
+	}
+	ctx.WritePlain(" ")
+	if err := stmt.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore the statement in an executable comment")
+	}
+	ctx.WritePlain(" */")
+	return nil
+}
+
 // IsReadOnly checks whether the input ast is readOnly.
 func IsReadOnly(node Node) bool {
 	switch st := node.(type) {
@@ -29,6 +76,12 @@ func IsReadOnly(node Node) bool {
 		return checker.readOnly
 	case *ExplainStmt, *DoStmt:
 		return true
+	case *AnalyzeStmt:
+		// ANALYZE executes the statement, so it is readonly only if the statement is.
+		return IsReadOnly(st.Stmt)
+	case *SetStatementStmt:
+		// The variables are only set for the statement, so it is readonly if the statement is.
+		return IsReadOnly(st.Stmt)
 	default:
 		return false
 	}
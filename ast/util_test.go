@@ -49,6 +49,15 @@ func (s *testCacheableSuite) TestCacheable(c *C) {
 
 	stmt = &DoStmt{}
 	c.Assert(IsReadOnly(stmt), IsTrue)
+
+	stmt = &AnalyzeStmt{Stmt: &UpdateStmt{}}
+	c.Assert(IsReadOnly(stmt), IsFalse)
+
+	stmt = &InstallPluginStmt{}
+	c.Assert(IsReadOnly(stmt), IsFalse)
+
+	stmt = &UninstallPluginStmt{}
+	c.Assert(IsReadOnly(stmt), IsFalse)
 }
 
 // CleanNodeText set the text of node and all child node empty.
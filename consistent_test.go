@@ -52,9 +52,11 @@ func (s *testConsistentSuite) TestKeywordConsistent(c *C) {
 		c.Assert(tokenMap[k], Equals, tokenMap[v])
 	}
 	keywordCount := len(reservedKeywords) + len(unreservedKeywords)
-	c.Assert(len(tokenMap)-len(aliases), Equals, keywordCount-len(windowFuncTokenMap))
+	c.Assert(len(tokenMap)-len(aliases), Equals, keywordCount-len(windowFuncTokenMap)-len(oracleTokenMap))
 
 	unreservedCollectionDef := extractKeywordsFromCollectionDef(content, "\nUnReservedKeyword:")
+	unreservedCollectionDef = append(unreservedCollectionDef, extractKeywordsFromCollectionDef(content, "\nBlockKeyword:")...)
+	sort.Strings(unreservedCollectionDef)
 	c.Assert(unreservedKeywords, DeepEquals, unreservedCollectionDef)
 
 	notKeywordTokensCollectionDef := extractKeywordsFromCollectionDef(content, "\nNotKeywordToken:")
@@ -120,12 +120,21 @@ func (d *sqlDigester) normalize(sql string) {
 		if pos.Offset == len(sql) {
 			break
 		}
+		if tok == identifier {
+			if tok1 := d.lexer.isTokenIdentifier(lit, pos.Offset); tok1 != 0 {
+				tok = tok1
+			}
+		}
 		currTok := token{tok, strings.ToLower(lit)}
 
 		if d.reduceOptimizerHint(&currTok) {
 			continue
 		}
 
+		if d.reduceSetStatement(&currTok) {
+			continue
+		}
+
 		d.reduceLit(&currTok)
 
 		d.tokens = append(d.tokens, currTok)
@@ -186,6 +195,29 @@ func (d *sqlDigester) reduceOptimizerHint(tok *token) (reduced bool) {
 	return
 }
 
+func (d *sqlDigester) reduceSetStatement(tok *token) (reduced bool) {
+	// ignore `set statement ... for`, the per-query variables do not change the statement
+	if tok.tok != forKwd || len(d.tokens) < 2 || d.tokens[0].tok != set || d.tokens[1].tok != statement {
+		return
+	}
+	depth := 0
+	for _, t := range d.tokens {
+		switch t.lit {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		}
+	}
+	// `for` inside the value expressions, such as `substring(a from 1 for 2)`
+	if depth != 0 {
+		return
+	}
+	d.tokens = d.tokens[:0]
+	reduced = true
+	return
+}
+
 func (d *sqlDigester) reduceLit(currTok *token) {
 	if !d.isLit(*currTok) {
 		return
@@ -47,6 +47,12 @@ func (s *testSQLDigestSuite) TestNormalize(c *C) {
 		{"select * from t USE Index(kk)", "select * from t"},
 		{"select * from t Ignore Index(kk)", "select * from t"},
 		{"select * from t1 straight_join t2 on t1.id=t2.id", "select * from t1 join t2 on t1 . id = t2 . id"},
+		{"set statement max_statement_time=5 for select * from t where a = 1", "select * from t where a = ?"},
+		{"set statement x=substring('abc' from 1 for 2), y=1 for select * from t for update", "select * from t for update"},
+		{"set statement = 1", "set statement = ?"},
+		{"set statement x='for' for select 1", "select ?"},
+		{"set statement x=`for` for select 1", "select ?"},
+		{"set statement x=t.for for select 1", "select ?"},
 		// test syntax error, it will be checked by parser, but it should not make normalize dead loop.
 		{"select * from t ignore index(", "select * from t ignore index"},
 		{"select /*+ ", "select "},
@@ -78,6 +84,7 @@ func (s *testSQLDigestSuite) TestDigestHashEqForSimpleSQL(c *C) {
 		{"select * from b where id = 1", "select * from b where id = '1'", "select * from b where id =2"},
 		{"select 2 from b, c where c.id > 1", "select 4 from b, c where c.id > 23"},
 		{"Select 3", "select 1"},
+		{"select * from t where a = 1", "set statement max_statement_time=5 for select * from t where a = 2", "SET STATEMENT optimizer_switch='index_merge=off', sort_buffer_size=1024 FOR select * from t where a = 3"},
 	}
 	for _, sqlGroup := range sqlGroups {
 		var d string
@@ -0,0 +1,114 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+)
+
+// Feature is a grammar construct which is not supported by every server version.
+type Feature int
+
+// Features.
+const (
+	FeatureRoles Feature = iota
+	FeatureCreateOrReplaceTable
+	FeatureCreateOrReplaceDatabase
+	FeatureCreateOrReplaceUser
+	FeatureCreateOrReplaceRole
+	FeatureCreateOrReplaceServer
+	FeatureCreateOrReplaceIndex
+	FeatureWindowFunctions
+	FeatureSequences
+	FeatureIntersectExcept
+	FeatureInet6
+	FeatureInsertReturning
+	FeatureIntersectExceptAll
+	FeatureIgnoredIndexes
+	FeatureJSONTable
+	FeatureUUID
+	FeatureVector
+	FeatureOracleProcedures
+	FeaturePackages
+)
+
+// features is the construct and the server version introducing it for each feature.
+// FeatureIntersectExcept, FeatureInsertReturning, FeatureIntersectExceptAll,
+// FeatureIgnoredIndexes and FeatureJSONTable are not checked yet, as the grammar
+// does not parse these constructs so far.
+// See https://mariadb.com/kb/en/changes-improvements-in-mariadb-10-0/ and the later ones.
+var features = []struct {
+	name    string
+	version int
+}{
+	FeatureRoles:                   {"roles", 100005},
+	FeatureCreateOrReplaceTable:    {"CREATE OR REPLACE TABLE", 100008},
+	FeatureCreateOrReplaceDatabase: {"CREATE OR REPLACE DATABASE", 100103},
+	FeatureCreateOrReplaceUser:     {"CREATE OR REPLACE USER", 100103},
+	FeatureCreateOrReplaceRole:     {"CREATE OR REPLACE ROLE", 100103},
+	FeatureCreateOrReplaceServer:   {"CREATE OR REPLACE SERVER", 100103},
+	FeatureCreateOrReplaceIndex:    {"CREATE OR REPLACE INDEX", 100104},
+	FeatureWindowFunctions:         {"window functions", 100200},
+	FeatureSequences:               {"sequences", 100301},
+	FeatureIntersectExcept:         {"INTERSECT and EXCEPT", 100300},
+	FeatureInet6:                   {"INET6 data type", 100500},
+	FeatureInsertReturning:         {"INSERT ... RETURNING", 100500},
+	FeatureIntersectExceptAll:      {"INTERSECT ALL and EXCEPT ALL", 100500},
+	FeatureIgnoredIndexes:          {"IGNORED indexes", 100600},
+	FeatureJSONTable:               {"JSON_TABLE", 100600},
+	FeatureUUID:                    {"UUID data type", 100700},
+	FeatureVector:                  {"vectors", 110701},
+	FeatureOracleProcedures:        {"sql_mode=ORACLE stored procedures", 100300},
+	FeaturePackages:                {"packages", 100305},
+}
+
+// String implements fmt.Stringer interface.
+func (f Feature) String() string {
+	return features[f].name
+}
+
+// Version returns the MYSQL_VERSION_ID of the server version introducing the feature.
+func (f Feature) Version() int {
+	return features[f].version
+}
+
+// ParseServerVersion converts a server version such as "10.6.11" or "10.6.11-MariaDB-log"
+// to the MYSQL_VERSION_ID format, such as 100611.
+func ParseServerVersion(version string) (int, error) {
+	if i := strings.IndexByte(version, '-'); i >= 0 {
+		version = version[:i]
+	}
+	parts := strings.Split(version, ".")
+	if len(parts) != 3 {
+		return 0, errors.Errorf("invalid server version %q", version)
+	}
+	id := 0
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || n > 99 {
+			return 0, errors.Errorf("invalid server version %q", version)
+		}
+		id = id*100 + n
+	}
+	return id, nil
+}
+
+// formatServerVersion converts a MYSQL_VERSION_ID such as 100611 to "10.6.11".
+func formatServerVersion(id int) string {
+	return fmt.Sprintf("%d.%d.%d", id/10000, id/100%100, id%100)
+}
@@ -21,6 +21,7 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/mia0x75/parser/ast"
 	"github.com/mia0x75/parser/mysql"
 )
 
@@ -55,6 +56,14 @@ type Scanner struct {
 	// lastScanOffset indicates last offset returned by scan().
 	// It's used to substring sql in syntax error message.
 	lastScanOffset int
+
+	// serverVersion is the MYSQL_VERSION_ID of the target server, which decides whether the
+	// content of a version gated comment is executed. All of them are executed if it is 0.
+	serverVersion int
+
+	// lastExecutableComment is the last executed comment and its offsets in the sql.
+	lastExecutableComment    *ast.ExecutableComment
+	lastExecutableCommentPos [2]int
 }
 
 type specialCommentScanner interface {
@@ -115,6 +124,7 @@ func (s *Scanner) reset(sql string) {
 	s.warns = s.warns[:0]
 	s.stmtStartPos = 0
 	s.specialComment = nil
+	s.lastExecutableComment = nil
 }
 
 func (s *Scanner) stmtText() string {
@@ -184,7 +194,7 @@ func (s *Scanner) Lex(v *yySymType) int {
 		tok = identifier
 	}
 
-	if tok == pipes && !(s.sqlMode.HasPipesAsConcatMode()) {
+	if tok == pipes && !(s.sqlMode.HasPipesAsConcatMode() || s.sqlMode.HasOracleMode()) {
 		return pipesAsOr
 	}
 
@@ -192,6 +202,20 @@ func (s *Scanner) Lex(v *yySymType) int {
 		return not2
 	}
 
+	if tok == with && s.scanRollup() {
+		return withRollup
+	}
+
+	if tok == vector && s.peekKeyOrIndex() {
+		return vectorIndex
+	}
+
+	if tok == '%' && s.sqlMode.HasOracleMode() {
+		if tok1 := s.scanPercentType(); tok1 != 0 {
+			return tok1
+		}
+	}
+
 	switch tok {
 	case intLit:
 		return toInt(s, v, lit)
@@ -217,6 +241,81 @@ func (s *Scanner) Lex(v *yySymType) int {
 	return tok
 }
 
+// scanRollup looks ahead of a WITH for the ROLLUP keyword and consumes it if found.
+// Merging them into one token keeps GROUP BY ... WITH ROLLUP apart from the
+// WITH CHECK OPTION of CREATE VIEW, which may follow a GROUP BY as well.
+func (s *Scanner) scanRollup() bool {
+	restore := s.mark()
+	tok, _, lit := s.scan()
+	if tok == identifier && strings.EqualFold(lit, "ROLLUP") {
+		return true
+	}
+	restore()
+	return false
+}
+
+// scanPercentType looks ahead of a '%' for the TYPE or ROWTYPE attribute of an anchored
+// type in sql_mode=ORACLE and consumes it if found, so that v%TYPE is not taken for a modulo.
+func (s *Scanner) scanPercentType() int {
+	if s.specialComment != nil {
+		return 0
+	}
+	r, errs, warns := s.r, len(s.errs), len(s.warns)
+	tok, _, lit := s.scan()
+	if tok == identifier {
+		if strings.EqualFold(lit, "TYPE") {
+			return percentType
+		}
+		if strings.EqualFold(lit, "ROWTYPE") {
+			return percentRowType
+		}
+	}
+	s.r, s.errs, s.warns = r, s.errs[:errs], s.warns[:warns]
+	s.specialComment = nil
+	return 0
+}
+
+// peekKeyOrIndex looks ahead of a VECTOR for the KEY or INDEX keyword without consuming it.
+// It tells a vector index from a column named vector in ALTER TABLE ... ADD, whose
+// COLUMN keyword is optional.
+func (s *Scanner) peekKeyOrIndex() bool {
+	restore := s.mark()
+	tok, _, lit := s.scan()
+	restore()
+	if tok != identifier {
+		return false
+	}
+	return strings.EqualFold(lit, "KEY") || strings.EqualFold(lit, "INDEX")
+}
+
+// mark returns a function which moves the scanner back to where it is now, so that the tokens
+// scanned in between are scanned again. It covers the scanner of the special comment being
+// read, if any, so that a lookahead works inside an executable comment too.
+func (s *Scanner) mark() func() {
+	r, errs, warns := s.r, len(s.errs), len(s.warns)
+	specialComment := s.specialComment
+	comment, commentPos := s.lastExecutableComment, s.lastExecutableCommentPos
+	var restoreSpecialComment func()
+	switch sc := specialComment.(type) {
+	case *mysqlSpecificCodeScanner:
+		restoreSpecialComment = sc.Scanner.mark()
+	case *optimizerHintScanner:
+		restoreScanner, end := sc.Scanner.mark(), sc.end
+		restoreSpecialComment = func() {
+			restoreScanner()
+			sc.end = end
+		}
+	}
+	return func() {
+		s.r, s.errs, s.warns = r, s.errs[:errs], s.warns[:warns]
+		s.specialComment = specialComment
+		s.lastExecutableComment, s.lastExecutableCommentPos = comment, commentPos
+		if restoreSpecialComment != nil {
+			restoreSpecialComment()
+		}
+	}
+}
+
 // SetSQLMode sets the SQL mode for scanner.
 func (s *Scanner) SetSQLMode(mode mysql.SQLMode) {
 	s.sqlMode = mode
@@ -227,6 +326,12 @@ func (s *Scanner) GetSQLMode() mysql.SQLMode {
 	return s.sqlMode
 }
 
+// SetServerVersion sets the MYSQL_VERSION_ID of the target server, such as 100611 for 10.6.11.
+// The content of a version gated comment is skipped if the server would not execute it.
+func (s *Scanner) SetServerVersion(version int) {
+	s.serverVersion = version
+}
+
 // EnableWindowFunc controls whether the scanner recognize the keywords of window function.
 func (s *Scanner) EnableWindowFunc(val bool) {
 	s.supportWindowFunc = val
@@ -238,6 +343,7 @@ func (s *Scanner) InheritScanner(sql string) *Scanner {
 		r:                 reader{s: sql},
 		sqlMode:           s.sqlMode,
 		supportWindowFunc: s.supportWindowFunc,
+		serverVersion:     s.serverVersion,
 	}
 }
 
@@ -431,7 +537,12 @@ func startWithSlash(s *Scanner) (tok int, pos Pos, lit string) {
 
 		// See http://dev.mysql.com/doc/refman/5.7/en/comments.html
 		// Convert "/*!VersionNumber MySQL-specific-code */" to "MySQL-specific-code".
-		if strings.HasPrefix(comment, "/*!") {
+		if executable := parseExecutableComment(comment); executable != nil {
+			if !s.executes(executable) {
+				return s.scan()
+			}
+			s.lastExecutableComment = executable
+			s.lastExecutableCommentPos = [2]int{pos.Offset, pos.Offset + len(comment)}
 			sql := specCodePattern.ReplaceAllStringFunc(comment, TrimComment)
 			s.specialComment = &mysqlSpecificCodeScanner{
 				Scanner: s.InheritScanner(sql),
@@ -449,6 +560,43 @@ func startWithSlash(s *Scanner) (tok int, pos Pos, lit string) {
 	return
 }
 
+// parseExecutableComment parses the marker of "/*!NNNNN code */" and of the MariaDB only
+// "/*M!NNNNNN code */". It returns nil if comment is not an executable comment.
+func parseExecutableComment(comment string) *ast.ExecutableComment {
+	var executable ast.ExecutableComment
+	switch {
+	case strings.HasPrefix(comment, "/*M!"):
+		executable.MariaDB = true
+		comment = comment[4:]
+	case strings.HasPrefix(comment, "/*!"):
+		comment = comment[3:]
+	default:
+		return nil
+	}
+	// Like the server, take 5 or 6 digits as the version, otherwise the comment has none.
+	digits := 0
+	for digits < 6 && digits < len(comment) && isDigit(rune(comment[digits])) {
+		digits++
+	}
+	if digits >= 5 {
+		executable.Version, _ = strconv.Atoi(comment[:digits])
+	}
+	return &executable
+}
+
+// executes reports whether the target server executes the content of the comment.
+// Like MariaDB, comments gated at the MySQL 5.7 and later versions which are below 10.0
+// are not executed unless they are in the "/*M!" form.
+func (s *Scanner) executes(comment *ast.ExecutableComment) bool {
+	if s.serverVersion == 0 || comment.Version == 0 {
+		return true
+	}
+	if comment.Version > s.serverVersion {
+		return false
+	}
+	return comment.Version < 50700 || comment.Version > 99999 || comment.MariaDB
+}
+
 func sqlOffsetInComment(comment string) int {
 	// find the first SQL token offset in pattern like "/*!40101 mysql specific code */"
 	offset := 0
@@ -269,6 +269,44 @@ func (s *testLexerSuite) TestSpecialComment(c *C) {
 	c.Assert(pos, Equals, Pos{1, 1, 16})
 }
 
+func (s *testLexerSuite) TestServerVersion(c *C) {
+	table := []struct {
+		src     string
+		version int
+		tok     int
+	}{
+		{"/*!40101 5*/", 100611, intLit},
+		{"/*!50701 5*/", 100611, 0},
+		{"/*M!50701 5*/", 100611, intLit},
+		{"/*!100500 5*/", 100611, intLit},
+		{"/*M!100500 5*/", 100611, intLit},
+		{"/*!110000 5*/", 100611, 0},
+		{"/*M!110000 5*/", 100611, 0},
+		{"/*! 5*/", 100611, intLit},
+		{"/*!50701 5*/", 0, intLit},
+		{"/*!110000 5*/", 0, intLit},
+	}
+	for _, t := range table {
+		l := NewScanner(t.src)
+		l.SetServerVersion(t.version)
+		tok, _, _ := l.scan()
+		c.Assert(tok, Equals, t.tok, Commentf("source %v", t.src))
+	}
+
+	comment := parseExecutableComment("/*M!100301 x */")
+	c.Assert(comment, NotNil)
+	c.Assert(comment.MariaDB, IsTrue)
+	c.Assert(comment.Version, Equals, 100301)
+	comment = parseExecutableComment("/*!40101 x */")
+	c.Assert(comment.MariaDB, IsFalse)
+	c.Assert(comment.Version, Equals, 40101)
+	c.Assert(parseExecutableComment("/* x */"), IsNil)
+
+	c.Assert(TrimComment("/*M!100301 x */"), Equals, "x")
+	c.Assert(TrimComment("/*!40101 x */"), Equals, "x")
+	c.Assert(TrimComment("/*!M x */"), Equals, "M x")
+}
+
 func (s *testLexerSuite) TestOptimizerHint(c *C) {
 	l := NewScanner("  /*+ BKA(t1) */")
 	tokens := []struct {
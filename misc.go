@@ -136,6 +136,7 @@ var tokenMap = map[string]int{
 	"ACTION":                   action,
 	"ADD":                      add,
 	"ADDDATE":                  addDate,
+	"ADMIN":                    admin,
 	"AFTER":                    after,
 	"ALL":                      all,
 	"ALGORITHM":                algorithm,
@@ -147,14 +148,18 @@ var tokenMap = map[string]int{
 	"AS":                       as,
 	"ASC":                      asc,
 	"ASCII":                    ascii,
+	"AUTHORS":                  authors,
 	"AUTO_INCREMENT":           autoIncrement,
 	"AVG":                      avg,
 	"AVG_ROW_LENGTH":           avgRowLength,
+	"BACKUP":                   backup,
 	"BEFORE":                   before,
 	"BEGIN":                    begin,
 	"BETWEEN":                  between,
 	"BIGINT":                   bigIntType,
 	"BINARY":                   binaryType,
+	"BINDING":                  binding,
+	"BINDINGS":                 bindings,
 	"BINLOG":                   binlog,
 	"BIT":                      bitType,
 	"BIT_AND":                  bitAnd,
@@ -162,12 +167,16 @@ var tokenMap = map[string]int{
 	"BIT_XOR":                  bitXor,
 	"BLOB":                     blobType,
 	"BLOCK":                    block,
+	"BLOCK_COMMIT":             blockCommit,
+	"BLOCK_DDL":                blockDDL,
+	"BODY":                     body,
 	"BOOL":                     boolType,
 	"BOOLEAN":                  booleanType,
 	"BOTH":                     both,
 	"BTREE":                    btree,
 	"BY":                       by,
 	"BYTE":                     byteType,
+	"CACHE":                    cache,
 	"CASCADE":                  cascade,
 	"CASCADED":                 cascaded,
 	"CASE":                     caseKwd,
@@ -181,7 +190,9 @@ var tokenMap = map[string]int{
 	"CIPHER":                   cipher,
 	"CLEANUP":                  cleanup,
 	"CLIENT":                   client,
+	"CLIENT_STATISTICS":        clientStatistics,
 	"COALESCE":                 coalesce,
+	"CODE":                     code,
 	"COLLATE":                  collate,
 	"COLLATION":                collation,
 	"COLUMN":                   column,
@@ -196,6 +207,7 @@ var tokenMap = map[string]int{
 	"CONSISTENT":               consistent,
 	"CONSTRAINT":               constraint,
 	"CONTEXT":                  context,
+	"CONTRIBUTORS":             contributors,
 	"CONVERT":                  convert,
 	"COPY":                     copyKwd,
 	"COUNT":                    count,
@@ -231,7 +243,9 @@ var tokenMap = map[string]int{
 	"DELETE":                   deleteKwd,
 	"DESC":                     desc,
 	"DESCRIBE":                 describe,
+	"DIRECTORY":                directory,
 	"DISABLE":                  disable,
+	"DISCARD":                  discard,
 	"DISTINCT":                 distinct,
 	"DISTINCTROW":              distinct,
 	"DIV":                      div,
@@ -244,10 +258,13 @@ var tokenMap = map[string]int{
 	"ELSE":                     elseKwd,
 	"ENABLE":                   enable,
 	"ENCLOSED":                 enclosed,
+	"ENCRYPTED":                encrypted,
+	"ENCRYPTION_KEY_ID":        encryptionKeyID,
 	"END":                      end,
 	"ENGINE":                   engine,
 	"ENGINES":                  engines,
 	"ENUM":                     enum,
+	"ERROR":                    errorKwd,
 	"ESCAPE":                   escape,
 	"ESCAPED":                  escaped,
 	"EVENT":                    event,
@@ -258,6 +275,8 @@ var tokenMap = map[string]int{
 	"EXISTS":                   exists,
 	"EXPIRE":                   expire,
 	"EXPLAIN":                  explain,
+	"EXPORT":                   export,
+	"EXTENDED":                 extended,
 	"EXTRACT":                  extract,
 	"FALSE":                    falseKwd,
 	"FAULTS":                   faultsSym,
@@ -275,6 +294,7 @@ var tokenMap = map[string]int{
 	"FULL":                     full,
 	"FULLTEXT":                 fulltext,
 	"FUNCTION":                 function,
+	"GENERAL":                  general,
 	"GENERATED":                generated,
 	"GET_FORMAT":               getFormat,
 	"GLOBAL":                   global,
@@ -282,22 +302,30 @@ var tokenMap = map[string]int{
 	"GRANTS":                   grants,
 	"GROUP":                    group,
 	"GROUP_CONCAT":             groupConcat,
+	"HARD":                     hard,
 	"HASH":                     hash,
 	"HAVING":                   having,
 	"HIGH_PRIORITY":            highPriority,
+	"HOST":                     host,
+	"HOSTS":                    hosts,
 	"HOUR":                     hour,
 	"HOUR_MICROSECOND":         hourMicrosecond,
 	"HOUR_MINUTE":              hourMinute,
 	"HOUR_SECOND":              hourSecond,
+	"ID":                       id,
 	"IDENTIFIED":               identified,
 	"IF":                       ifKwd,
 	"IGNORE":                   ignore,
+	"IMPORT":                   importKwd,
 	"IN":                       in,
 	"INDEX":                    index,
 	"INDEXES":                  indexes,
+	"INDEX_STATISTICS":         indexStatistics,
+	"INET6":                    inet6,
 	"INFILE":                   infile,
 	"INNER":                    inner,
 	"INPLACE":                  inplace,
+	"INSTALL":                  install,
 	"INSTANT":                  instant,
 	"INSERT":                   insert,
 	"INT":                      intType,
@@ -333,6 +361,7 @@ var tokenMap = map[string]int{
 	"LINEAR":                   linear,
 	"LOAD":                     load,
 	"LOCAL":                    local,
+	"LOCALES":                  locales,
 	"LOCALTIME":                localTime,
 	"LOCALTIMESTAMP":           localTs,
 	"LOCK":                     lock,
@@ -347,6 +376,7 @@ var tokenMap = map[string]int{
 	"MAX_EXECUTION_TIME":       maxExecutionTime,
 	"MAX_QUERIES_PER_HOUR":     maxQueriesPerHour,
 	"MAX_ROWS":                 maxRows,
+	"MAX_STATEMENT_TIME":       maxStatementTime,
 	"MAX_UPDATES_PER_HOUR":     maxUpdatesPerHour,
 	"MAX_USER_CONNECTIONS":     maxUserConnections,
 	"MAXVALUE":                 maxValue,
@@ -365,6 +395,8 @@ var tokenMap = map[string]int{
 	"MODE":                     mode,
 	"MODIFY":                   modify,
 	"MONTH":                    month,
+	"MUTEX":                    mutex,
+	"NAME":                     name,
 	"NAMES":                    names,
 	"NATIONAL":                 national,
 	"NATURAL":                  natural,
@@ -384,15 +416,23 @@ var tokenMap = map[string]int{
 	"ONLY":                     only,
 	"OPTION":                   option,
 	"OPTIONALLY":               optionally,
+	"OPTIONS":                  options,
 	"OR":                       or,
 	"ORDER":                    order,
 	"OUTER":                    outer,
+	"OWNER":                    owner,
+	"PACKAGE":                  packageKwd,
 	"PACK_KEYS":                packKeys,
 	"PAGE":                     pageSym,
+	"PAGE_COMPRESSED":          pageCompressed,
+	"PAGE_COMPRESSION_LEVEL":   pageCompressionLevel,
 	"PARTITION":                partition,
 	"PARTITIONS":               partitions,
 	"PASSWORD":                 password,
+	"PERSISTENT":               persistent,
+	"PLUGIN":                   plugin,
 	"PLUGINS":                  plugins,
+	"PORT":                     port,
 	"POSITION":                 position,
 	"PRECEDING":                preceding,
 	"PRECISION":                precisionType,
@@ -404,11 +444,17 @@ var tokenMap = map[string]int{
 	"PROCESSLIST":              processlist,
 	"PROFILE":                  profile,
 	"PROFILES":                 profiles,
+	"PROXY":                    proxy,
 	"PURGE":                    purge,
 	"QUARTER":                  quarter,
 	"QUERY":                    query,
 	"QUERIES":                  queries,
+	"QUERY_RESPONSE_TIME":      queryResponseTime,
 	"QUICK":                    quick,
+	"RELAY":                    relay,
+	"ROLLUP":                   rollup,
+	"SEQUENCE":                 sequence,
+	"SERVER":                   server,
 	"SHARD_ROW_ID_BITS":        shardRowIDBits,
 	"RANGE":                    rangeKwd,
 	"RECOVER":                  recover,
@@ -435,6 +481,7 @@ var tokenMap = map[string]int{
 	"ROLLBACK":                 rollback,
 	"ROUTINE":                  routine,
 	"ROW":                      row,
+	"ROWS":                     rows,
 	"ROW_COUNT":                rowCount,
 	"ROW_FORMAT":               rowFormat,
 	"SCHEMA":                   database,
@@ -455,7 +502,10 @@ var tokenMap = map[string]int{
 	"SLOW":                     slow,
 	"SMALLINT":                 smallIntType,
 	"SNAPSHOT":                 snapshot,
+	"SOCKET":                   socket,
+	"SOFT":                     soft,
 	"SOME":                     some,
+	"SONAME":                   soname,
 	"SQL":                      sql,
 	"SQL_BIG_RESULT":           sqlBigResult,
 	"SQL_BUFFER_RESULT":        sqlBufferResult,
@@ -465,10 +515,13 @@ var tokenMap = map[string]int{
 	"SQL_SMALL_RESULT":         sqlSmallResult,
 	"SOURCE":                   source,
 	"SSL":                      ssl,
+	"STAGE":                    stage,
 	"START":                    start,
 	"STARTING":                 starting,
+	"STATEMENT":                statement,
 	"STATS_PERSISTENT":         statsPersistent,
 	"STATUS":                   status,
+	"STORAGE":                  storage,
 	"SWAPS":                    swaps,
 	"SWITCHES":                 switchesSym,
 	"OPEN":                     open,
@@ -489,6 +542,7 @@ var tokenMap = map[string]int{
 	"TABLE":                    tableKwd,
 	"TABLES":                   tables,
 	"TABLESPACE":               tablespace,
+	"TABLE_STATISTICS":         tableStatistics,
 	"TEMPORARY":                temporary,
 	"TEMPTABLE":                temptable,
 	"TERMINATED":               terminated,
@@ -522,19 +576,24 @@ var tokenMap = map[string]int{
 	"UNBOUNDED":                unbounded,
 	"UNCOMMITTED":              uncommitted,
 	"UNDEFINED":                undefined,
+	"UNINSTALL":                uninstall,
 	"UNION":                    union,
 	"UNIQUE":                   unique,
 	"UNKNOWN":                  unknown,
 	"UNLOCK":                   unlock,
 	"UNSIGNED":                 unsigned,
 	"UPDATE":                   update,
+	"UPGRADE":                  upgrade,
 	"USAGE":                    usage,
 	"USE":                      use,
 	"USER":                     user,
+	"USER_RESOURCES":           userResources,
+	"USER_STATISTICS":          userStatistics,
 	"USING":                    using,
 	"UTC_DATE":                 utcDate,
 	"UTC_TIME":                 utcTime,
 	"UTC_TIMESTAMP":            utcTimestamp,
+	"UUID":                     uuid,
 	"VALUE":                    value,
 	"VALUES":                   values,
 	"VARBINARY":                varbinaryType,
@@ -543,6 +602,8 @@ var tokenMap = map[string]int{
 	"VARIANCE":                 varPop,
 	"VAR_POP":                  varPop,
 	"VAR_SAMP":                 varSamp,
+	"VECTOR":                   vector,
+	"VIA":                      via,
 	"VIEW":                     view,
 	"VIRTUAL":                  virtual,
 	"WARNINGS":                 warnings,
@@ -551,11 +612,16 @@ var tokenMap = map[string]int{
 	"WHEN":                     when,
 	"WHERE":                    where,
 	"WITH":                     with,
+	"WRAPPER":                  wrapper,
 	"WRITE":                    write,
+	"WSREP_MEMBERSHIP":         wsrepMembership,
+	"WSREP_STATUS":             wsrepStatus,
+	"XML":                      xml,
 	"XOR":                      xor,
 	"X509":                     x509,
 	"YEAR":                     yearType,
 	"YEAR_MONTH":               yearMonth,
+	"YES":                      yes,
 	"ZEROFILL":                 zerofill,
 }
 
@@ -573,6 +639,7 @@ var btFuncTokenMap = map[string]int{
 	"DATE_SUB":     builtinDateSub,
 	"EXTRACT":      builtinExtract,
 	"GROUP_CONCAT": builtinGroupConcat,
+	"GROUPING":     builtinGrouping,
 	"MAX":          builtinMax,
 	"MID":          builtinSubstring,
 	"MIN":          builtinMin,
@@ -608,11 +675,22 @@ var windowFuncTokenMap = map[string]int{
 	"OVER":         over,
 	"PERCENT_RANK": percentRank,
 	"RANK":         rank,
-	"ROWS":         rows,
 	"ROW_NUMBER":   rowNumber,
 	"WINDOW":       window,
 }
 
+// oracleTokenMap are the keywords which are only recognized in sql_mode=ORACLE.
+var oracleTokenMap = map[string]int{
+	"CLOB":      clobType,
+	"DECLARE":   declare,
+	"EXCEPTION": exception,
+	"INOUT":     inout,
+	"NUMBER":    numberType,
+	"OUT":       out,
+	"RAW":       rawType,
+	"VARCHAR2":  varchar2Type,
+}
+
 // aliases are strings directly map to another string and use the same token.
 var aliases = map[string]string{
 	"SCHEMA":  "DATABASE",
@@ -658,7 +736,10 @@ func (s *Scanner) isTokenIdentifier(lit string, offset int) int {
 	}
 	tok, ok := tokenMap[string(data)]
 	if !ok && s.supportWindowFunc {
-		tok = windowFuncTokenMap[string(data)]
+		tok, ok = windowFuncTokenMap[string(data)]
+	}
+	if !ok && s.sqlMode.HasOracleMode() {
+		tok = oracleTokenMap[string(data)]
 	}
 	return tok
 }
@@ -84,11 +84,14 @@ type ColumnInfo struct {
 	State               SchemaState         `json:"state"`
 	Comment             string              `json:"comment"`
 	Version             uint64              `json:"version"`
+	// EngineAttributes are the storage engine defined column options.
+	EngineAttributes []*EngineAttribute `json:"engine_attributes,omitempty"`
 }
 
 // Clone clones ColumnInfo.
 func (c *ColumnInfo) Clone() *ColumnInfo {
 	nc := *c
+	nc.EngineAttributes = cloneEngineAttributes(c.EngineAttributes)
 	return &nc
 }
 
@@ -159,6 +162,43 @@ const (
 	CurrLatestTableInfoVersion = TableInfoVersion1
 )
 
+// EngineAttributeValueType is the value type of an engine defined attribute.
+type EngineAttributeValueType byte
+
+// Engine defined attribute value types.
+const (
+	EngineAttributeValueString EngineAttributeValueType = iota
+	EngineAttributeValueNumber
+	EngineAttributeValueIdent
+	EngineAttributeValueDefault
+)
+
+// EngineAttribute is a `name = value` option declared by a storage engine for a table, column or index,
+// e.g. TABLE_TYPE=CSV of the CONNECT engine.
+// See https://mariadb.com/kb/en/engine-defined-new-tablefieldindex-attributes/
+type EngineAttribute struct {
+	Name  string                   `json:"name"`
+	Tp    EngineAttributeValueType `json:"type"`
+	Value string                   `json:"value"`
+}
+
+// Clone clones EngineAttribute.
+func (a *EngineAttribute) Clone() *EngineAttribute {
+	na := *a
+	return &na
+}
+
+func cloneEngineAttributes(attrs []*EngineAttribute) []*EngineAttribute {
+	if attrs == nil {
+		return nil
+	}
+	nattrs := make([]*EngineAttribute, len(attrs))
+	for i := range attrs {
+		nattrs[i] = attrs[i].Clone()
+	}
+	return nattrs
+}
+
 // ExtraHandleName is the name of ExtraHandle Column.
 var ExtraHandleName = NewCIStr("_tidb_rowid")
 
@@ -196,6 +236,16 @@ type TableInfo struct {
 
 	Compression string `json:"compression"`
 
+	// PageCompressed, PageCompressionLevel, Encrypted and EncryptionKeyID are the
+	// page compression and encryption options of InnoDB and Aria.
+	PageCompressed       bool   `json:"page_compressed,omitempty"`
+	PageCompressionLevel uint64 `json:"page_compression_level,omitempty"`
+	Encrypted            bool   `json:"encrypted,omitempty"`
+	EncryptionKeyID      uint64 `json:"encryption_key_id,omitempty"`
+
+	// EngineAttributes are the storage engine defined table options.
+	EngineAttributes []*EngineAttribute `json:"engine_attributes,omitempty"`
+
 	View *ViewInfo `json:"view"`
 
 	// Version means the version of the table info.
@@ -243,6 +293,8 @@ func (t *TableInfo) Clone() *TableInfo {
 		nt.ForeignKeys[i] = t.ForeignKeys[i].Clone()
 	}
 
+	nt.EngineAttributes = cloneEngineAttributes(t.EngineAttributes)
+
 	return &nt
 }
 
@@ -509,6 +561,36 @@ const (
 	IndexTypeHash
 )
 
+// VectorDistance is the distance function of a vector index.
+type VectorDistance int
+
+// VectorDistances
+const (
+	VectorDistanceDefault VectorDistance = iota
+	VectorDistanceEuclidean
+	VectorDistanceCosine
+)
+
+// String implements Stringer interface.
+func (d VectorDistance) String() string {
+	switch d {
+	case VectorDistanceEuclidean:
+		return "EUCLIDEAN"
+	case VectorDistanceCosine:
+		return "COSINE"
+	default:
+		return ""
+	}
+}
+
+// VectorIndexInfo is the options of a MariaDB vector index.
+// See https://mariadb.com/kb/en/create-table-with-vectors/
+type VectorIndexInfo struct {
+	// M is the number of neighbors of each node, 0 means the server default.
+	M        uint64         `json:"m"`
+	Distance VectorDistance `json:"distance"`
+}
+
 // IndexInfo provides meta data describing a DB index.
 // It corresponds to the statement `CREATE INDEX Name ON Table (Column);`
 // See https://dev.mysql.com/doc/refman/5.7/en/create-index.html
@@ -522,15 +604,24 @@ type IndexInfo struct {
 	State   SchemaState    `json:"state"`
 	Comment string         `json:"comment"`    // Comment
 	Tp      IndexType      `json:"index_type"` // Index type: Btree or Hash
+	// EngineAttributes are the storage engine defined index options.
+	EngineAttributes []*EngineAttribute `json:"engine_attributes,omitempty"`
+	// VectorInfo is only set for vector indexes.
+	VectorInfo *VectorIndexInfo `json:"vector_info,omitempty"`
 }
 
 // Clone clones IndexInfo.
 func (index *IndexInfo) Clone() *IndexInfo {
 	ni := *index
+	if index.VectorInfo != nil {
+		vi := *index.VectorInfo
+		ni.VectorInfo = &vi
+	}
 	ni.Columns = make([]*IndexColumn, len(index.Columns))
 	for i := range index.Columns {
 		ni.Columns[i] = index.Columns[i].Clone()
 	}
+	ni.EngineAttributes = cloneEngineAttributes(index.EngineAttributes)
 	return &ni
 }
 
@@ -574,6 +665,7 @@ type DBInfo struct {
 	Name    CIStr        `json:"db_name"` // DB name.
 	Charset string       `json:"charset"`
 	Collate string       `json:"collate"`
+	Comment string       `json:"comment"`
 	Tables  []*TableInfo `json:"-"` // Tables in the DB.
 	State   SchemaState  `json:"state"`
 }
@@ -90,6 +90,19 @@ func (*testModelSuite) TestModelBasic(c *C) {
 	n := dbInfo.Clone()
 	c.Assert(n, DeepEquals, dbInfo)
 
+	// The engine defined attributes are not shared with the clone.
+	column.EngineAttributes = []*EngineAttribute{{Name: "FIELD_FORMAT", Tp: EngineAttributeValueString, Value: "%n"}}
+	index.EngineAttributes = []*EngineAttribute{{Name: "CLUSTERING", Tp: EngineAttributeValueIdent, Value: "YES"}}
+	table.EngineAttributes = []*EngineAttribute{{Name: "TABLE_TYPE", Tp: EngineAttributeValueIdent, Value: "CSV"}}
+	nt := table.Clone()
+	c.Assert(nt, DeepEquals, table)
+	nt.Columns[0].EngineAttributes[0].Value = "%s"
+	nt.Indices[0].EngineAttributes[0].Value = "NO"
+	nt.EngineAttributes[0].Value = "DBF"
+	c.Assert(column.EngineAttributes[0].Value, Equals, "%n")
+	c.Assert(index.EngineAttributes[0].Value, Equals, "YES")
+	c.Assert(table.EngineAttributes[0].Value, Equals, "CSV")
+
 	pkName := table.GetPkName()
 	c.Assert(pkName, Equals, NewCIStr("c"))
 	newColumn := table.GetPkColInfo()
@@ -498,6 +498,11 @@ func (m SQLMode) HasPipesAsConcatMode() bool {
 	return m&ModePipesAsConcat == ModePipesAsConcat
 }
 
+// HasOracleMode detects if 'ORACLE' mode is set in SQLMode
+func (m SQLMode) HasOracleMode() bool {
+	return m&ModeOracle == ModeOracle
+}
+
 // HasNoUnsignedSubtractionMode detects if 'NO_UNSIGNED_SUBTRACTION' mode is set in SQLMode
 func (m SQLMode) HasNoUnsignedSubtractionMode() bool {
 	return m&ModeNoUnsignedSubtraction == ModeNoUnsignedSubtraction
@@ -543,6 +548,16 @@ func (m SQLMode) HasAllowInvalidDatesMode() bool {
 	return m&ModeAllowInvalidDates == ModeAllowInvalidDates
 }
 
+// RestoreFlags adjusts the quoting of flags so that SQL restored with them is read back the same under the SQL mode.
+// With 'ANSI_QUOTES', names are double-quoted and strings single-quoted, since a double-quoted string would be an identifier.
+func (m SQLMode) RestoreFlags(flags RestoreFlags) RestoreFlags {
+	if m.HasANSIQuotesMode() {
+		flags &^= RestoreStringDoubleQuotes | RestoreNameBackQuotes
+		flags |= RestoreStringSingleQuotes | RestoreNameDoubleQuotes
+	}
+	return flags
+}
+
 // consts for sql modes.
 const (
 	ModeNone        SQLMode = 0
@@ -920,5 +920,6 @@ const (
 	ErrWindowExplainJSON                                            = 3598
 	ErrWindowFunctionIgnoresFrame                                   = 3599
 
-	ErrTxnTooLarge = 9500
+	ErrTxnTooLarge     = 9500
+	ErrBindingMismatch = 9501
 )
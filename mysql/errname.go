@@ -916,5 +916,6 @@ var MySQLErrName = map[uint16]string{
 	ErrRoleNotGranted:                                        "%s is is not granted to %s",
 	ErrMaxExecTimeExceeded:                                   "Query execution was interrupted, max_execution_time exceeded.",
 
-	ErrTxnTooLarge: "Transaction is too large",
+	ErrTxnTooLarge:     "Transaction is too large",
+	ErrBindingMismatch: "Hinted query does not match the original query of the binding",
 }
@@ -34,6 +34,11 @@ const (
 	TypeVarchar  byte = 15
 	TypeBit      byte = 16
 
+	// TypeVector, TypeInet6 and TypeUUID are MariaDB data types. MariaDB reports
+	// them as plugin types on the wire, so the codes below are only used internally.
+	TypeVector     byte = 0xf2
+	TypeInet6      byte = 0xf3
+	TypeUUID       byte = 0xf4
 	TypeJSON       byte = 0xf5
 	TypeNewDecimal byte = 0xf6
 	TypeEnum       byte = 0xf7
@@ -26,6 +26,7 @@
 package parser
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/mia0x75/parser/mysql"
@@ -107,6 +108,7 @@ import (
 	dayMinute         "DAY_MINUTE"
 	daySecond         "DAY_SECOND"
 	decimalType       "DECIMAL"
+	declare           "DECLARE"
 	defaultKwd        "DEFAULT"
 	delayed           "DELAYED"
 	deleteKwd         "DELETE"
@@ -125,6 +127,7 @@ import (
 	exists            "EXISTS"
 	explain           "EXPLAIN"
 	except            "EXCEPT"
+	exception         "EXCEPTION"
 	falseKwd          "FALSE"
 	firstValue        "FIRST_VALUE"
 	floatType         "FLOAT"
@@ -148,6 +151,7 @@ import (
 	index             "INDEX"
 	infile            "INFILE"
 	inner             "INNER"
+	inout             "INOUT"
 	integerType       "INTEGER"
 	interval          "INTERVAL"
 	into              "INTO"
@@ -198,6 +202,7 @@ import (
 	optionally        "OPTIONALLY"
 	or                "OR"
 	order             "ORDER"
+	out               "OUT"
 	outer             "OUTER"
 	over              "OVER"
 	packKeys          "PACK_KEYS"
@@ -222,7 +227,6 @@ import (
 	right             "RIGHT"
 	rlike             "RLIKE"
 	row               "ROW"
-	rows              "ROWS"
 	rowNumber         "ROW_NUMBER"
 	secondMicrosecond "SECOND_MICROSECOND"
 	selectKwd         "SELECT"
@@ -320,6 +324,7 @@ import (
 	definer               "DEFINER"
 	delayKeyWrite         "DELAY_KEY_WRITE"
 	disable               "DISABLE"
+	discard               "DISCARD"
 	do                    "DO"
 	duplicate             "DUPLICATE"
 	dynamic               "DYNAMIC"
@@ -347,6 +352,7 @@ import (
 	hash                  "HASH"
 	hour                  "HOUR"
 	identified            "IDENTIFIED"
+	importKwd             "IMPORT"
 	isolation             "ISOLATION"
 	issuer                "ISSUER"
 	indexes               "INDEXES"
@@ -463,6 +469,74 @@ import (
 	purge                 "PURGE"
 	logs                  "LOGS"
 	before                "BEFORE"
+	extended              "EXTENDED"
+	install               "INSTALL"
+	plugin                "PLUGIN"
+	soname                "SONAME"
+	uninstall             "UNINSTALL"
+	authors               "AUTHORS"
+	body                  "BODY"
+	clientStatistics      "CLIENT_STATISTICS"
+	code                  "CODE"
+	contributors          "CONTRIBUTORS"
+	indexStatistics       "INDEX_STATISTICS"
+	locales               "LOCALES"
+	mutex                 "MUTEX"
+	packageKwd            "PACKAGE"
+	queryResponseTime     "QUERY_RESPONSE_TIME"
+	sequence              "SEQUENCE"
+	statement             "STATEMENT"
+	hard                  "HARD"
+	id                    "ID"
+	soft                  "SOFT"
+	backup                "BACKUP"
+	blockCommit           "BLOCK_COMMIT"
+	blockDDL              "BLOCK_DDL"
+	cache                 "CACHE"
+	errorKwd              "ERROR"
+	export                "EXPORT"
+	general               "GENERAL"
+	hosts                 "HOSTS"
+	relay                 "RELAY"
+	stage                 "STAGE"
+	userResources         "USER_RESOURCES"
+	xml                   "XML"
+	encrypted             "ENCRYPTED"
+	encryptionKeyID       "ENCRYPTION_KEY_ID"
+	pageCompressed        "PAGE_COMPRESSED"
+	pageCompressionLevel  "PAGE_COMPRESSION_LEVEL"
+	yes                   "YES"
+	inet6                 "INET6"
+	uuid                  "UUID"
+	vector                "VECTOR"
+	persistent            "PERSISTENT"
+	rollup                "ROLLUP"
+	storage               "STORAGE"
+	tableStatistics       "TABLE_STATISTICS"
+	userStatistics        "USER_STATISTICS"
+	wsrepMembership       "WSREP_MEMBERSHIP"
+	wsrepStatus           "WSREP_STATUS"
+	via                   "VIA"
+	admin                 "ADMIN"
+	maxStatementTime      "MAX_STATEMENT_TIME"
+	proxy                 "PROXY"
+	server                "SERVER"
+	wrapper               "WRAPPER"
+	options               "OPTIONS"
+	host                  "HOST"
+	port                  "PORT"
+	socket                "SOCKET"
+	owner                 "OWNER"
+	upgrade               "UPGRADE"
+	directory             "DIRECTORY"
+	name                  "NAME"
+	binding               "BINDING"
+	bindings              "BINDINGS"
+	rows                  "ROWS"
+	clobType              "CLOB"
+	numberType            "NUMBER"
+	rawType               "RAW"
+	varchar2Type          "VARCHAR2"
 
 	/* The following tokens belong to NotKeywordToken. */
 	addDate            "ADDDATE"
@@ -524,6 +598,7 @@ import (
 	builtinDateSub
 	builtinExtract
 	builtinGroupConcat
+	builtinGrouping
 	builtinMax
 	builtinMin
 	builtinNow
@@ -564,6 +639,15 @@ import (
 
 %token not2
 
+/* withRollup is WITH immediately followed by ROLLUP, see Scanner.Lex. */
+%token withRollup
+
+/* vectorIndex is VECTOR immediately followed by KEY or INDEX, see Scanner.Lex. */
+%token vectorIndex
+
+/* percentType and percentRowType are %TYPE and %ROWTYPE in sql_mode=ORACLE, see Scanner.Lex. */
+%token percentType percentRowType
+
 
 %type	<expr>
 	Expression                      /* expression */
@@ -595,22 +679,31 @@ import (
 	AlterTableStmt                  /* Alter table statement */
 	AlterDatabaseStmt               /* Alter database statement */
 	AlterUserStmt                   /* Alter user statement */
+	AlterServerStmt                 /* Alter server statement */
+	AnalyzeStmt                     /* MariaDB ANALYZE statement */
 	AnalyzeTableStmt                /* Analyze table statement */
+	BackupStmt                      /* BACKUP STAGE/LOCK/UNLOCK statement */
 	BeginTransactionStmt            /* BEGIN TRANSACTION statement */
 	BinlogStmt                      /* Binlog base64 statement */
 	CommitStmt                      /* COMMIT statement */
+	CreateBindingStmt               /* CREATE BINDING statement */
+	CreatePackageStmt               /* CREATE PACKAGE [BODY] statement */
+	CreateProcedureStmt             /* CREATE PROCEDURE statement */
 	CreateTableStmt                 /* CREATE TABLE statement */
 	CreateViewStmt                  /* CREATE VIEW stetement */
 	CreateUserStmt                  /* CREATE User statement */
 	CreateRoleStmt                  /* CREATE Role statement */
+	CreateServerStmt                /* CREATE SERVER statement */
 	CreateDatabaseStmt              /* Create Database Statement */
 	CreateIndexStmt                 /* CREATE INDEX statement */
 	DoStmt                          /* Do statement */
+	DropBindingStmt                 /* DROP BINDING statement */
 	DropDatabaseStmt                /* DROP DATABASE statement */
 	DropIndexStmt                   /* DROP INDEX statement */
 	DropTableStmt                   /* DROP TABLE statement */
 	DropUserStmt                    /* DROP USER */
 	DropRoleStmt                    /* DROP ROLE */
+	DropServerStmt                  /* DROP SERVER */
 	DropViewStmt                    /* DROP VIEW statement */
 	DeallocateStmt                  /* Deallocate prepared statement */
 	DeleteFromStmt                  /* DELETE FROM statement */
@@ -621,23 +714,29 @@ import (
 	FlushStmt                       /* Flush statement */
 	GrantStmt                       /* Grant statement */
 	GrantRoleStmt                   /* Grant role statement */
+	GrantProxyStmt                  /* Grant proxy statement */
 	InsertIntoStmt                  /* INSERT INTO statement */
+	InstallPluginStmt               /* INSTALL PLUGIN statement */
 	KillStmt                        /* Kill statement */
 	LoadDataStmt                    /* Load data statement */
 	LockTablesStmt                  /* Lock tables statement */
 	PreparedStmt                    /* PreparedStmt */
 	SelectStmt                      /* SELECT statement */
 	RenameTableStmt                 /* rename table statement */
+	RenameUserStmt                  /* rename user statement */
 	ReplaceIntoStmt                 /* REPLACE INTO statement */
 	RevokeStmt                      /* Revoke statement */
 	RevokeRoleStmt                  /* Revoke role statement */
+	RevokeProxyStmt                 /* Revoke proxy statement */
 	RollbackStmt                    /* ROLLBACK statement */
 	SetStmt                         /* Set variable statement */
+	SetStatementStmt                /* SET STATEMENT ... FOR statement */
 	SetRoleStmt                     /* Set active role statement */
 	SetDefaultRoleStmt              /* Set default statement for some user */
 	ShowStmt                        /* Show engines/databases/tables/user/columns/warnings/status statement */
 	Statement                       /* statement */
 	TruncateTableStmt               /* TRUNCATE TABLE statement */
+	UninstallPluginStmt             /* UNINSTALL PLUGIN statement */
 	UnlockTablesStmt                /* Unlock tables statement */
 	UpdateStmt                      /* UPDATE statement */
 	UnionStmt                       /* Union select state ment */
@@ -647,19 +746,36 @@ import (
 
 %type	<item>
 	AlterAlgorithm                  /* Alter table algorithm */
+	AlgorithmClause                 /* Online DDL algorithm clause */
+	AlterOrderItem                  /* Alter table order item */
+	AlterOrderList                  /* Alter table order list */
 	AlterTableOptionListOpt         /* alter table option list opt */
 	AlterTableSpec                  /* Alter table specification */
 	AlterTableSpecList              /* Alter table specification list */
+	AnalyzeColumnList               /* ANALYZE TABLE PERSISTENT FOR column list */
+	AnalyzeColumnsSpec              /* ANALYZE TABLE PERSISTENT FOR COLUMNS list or ALL */
+	AnalyzeIndexesSpec              /* ANALYZE TABLE PERSISTENT FOR INDEXES list or ALL */
+	AnalyzePersistentForOpt         /* ANALYZE TABLE optional PERSISTENT FOR clause */
 	AnyOrAll                        /* Any or All for subquery */
 	Assignment                      /* assignment */
 	AssignmentList                  /* assignment list */
 	AssignmentListOpt               /* assignment list opt */
 	AuthOption                      /* User auth option */
+	AuthPlugin                      /* IDENTIFIED VIA authentication rule */
+	AuthPluginList                  /* IDENTIFIED VIA authentication rule list */
 	AuthString                      /* Password string value */
 	OptionalBraces                  /* optional braces */
 	CastType                        /* Cast function target type */
 	CharsetName                     /* Character set name */
 	ClearPasswordExpireOptions      /* Clear password expire options */
+	CreateRoleAdminOpt              /* CREATE ROLE WITH ADMIN option */
+	GrantOptionList                 /* GRANT WITH option list */
+	GrantOption                     /* GRANT OPTION or resource option of GRANT WITH */
+	GrantOptions                    /* GRANT WITH options */
+	SetDefaultRoleUsersOpt          /* SET DEFAULT ROLE optional TO/FOR user clause */
+	UserToUser                      /* rename user pair */
+	UserToUserList                  /* rename user pair list */
+	WithAdminOptionOpt              /* GRANT role WITH ADMIN OPTION option */
 	CollationName                   /* Collation name */
 	ColumnDef                       /* table column definition */
 	ColumnDefList                   /* table column definition list */
@@ -697,6 +813,24 @@ import (
 	BuggyDefaultFalseDistinctOpt    /* Distinct option which accepts DISTINCT ALL and defaults to false */
 	RequireClause                   /* Encrypted connections options */
 	EqOpt                           /* = or empty */
+	AsOrIs                          /* AS or IS */
+	ExceptionNameList               /* Exception name list of an exception handler */
+	PackageItemListOpt              /* Variable declarations and procedures of a package */
+	PackageProcedure                /* Procedure of a package */
+	ProcedureBlockBody              /* BEGIN ... END block of a stored program */
+	ProcedureDecl                   /* Variable declaration of a stored program */
+	ProcedureDeclListOpt            /* Variable declaration list of a stored program */
+	ProcedureExceptionHandler       /* WHEN ... THEN exception handler */
+	ProcedureExceptionHandlerList   /* Exception handler list */
+	ProcedureExceptionOpt           /* EXCEPTION section of a block */
+	ProcedureParam                  /* Stored procedure parameter */
+	ProcedureParamList              /* Stored procedure parameter list */
+	ProcedureParamListOpt           /* Stored procedure parameter list or empty */
+	ProcedureParamMode              /* IN, OUT or IN OUT */
+	ProcedureParamType              /* Stored procedure parameter type */
+	ProcedureStmt                   /* Statement of a stored program */
+	ProcedureStmtList               /* Statement list of a stored program */
+	VariableType                    /* Data type of a stored program variable */
 	EscapedTableRef                 /* escaped table reference */
 	ExpressionList                  /* expression list */
 	MaxValueOrExpressionList        /* maxvalue or expression list */
@@ -708,7 +842,9 @@ import (
 	FieldAsName                     /* Field alias name */
 	FieldAsNameOpt                  /* Field alias name opt */
 	FieldList                       /* field expression list */
+	BackupStage                     /* BACKUP STAGE stage */
 	FlushOption                     /* Flush option */
+	FlushLogType                    /* FLUSH LOGS log type */
 	TableRefsClause                 /* Table references clause */
 	FieldItem                       /* Field item for load data clause */
 	FieldItemList                   /* Field items for load data clause */
@@ -748,7 +884,14 @@ import (
 	LoadDataSetList                 /* Load data specifications */
 	LoadDataSetItem                 /* Single load data specification */
 	LocalOpt                        /* Local opt */
+	CharsetOpt                      /* LOAD DATA/XML character set */
+	EngineAttribute                 /* Storage engine defined option */
+	EngineAttributeValue            /* Storage engine defined option value */
+	YesOrNo                         /* YES or NO */
 	LockClause                      /* Alter table lock clause */
+	LockAndAlgorithmOpt             /* Optional online DDL lock and algorithm clauses */
+	KillHardOpt                     /* Kill statement HARD or SOFT option */
+	KillTypeOpt                     /* Kill statement CONNECTION or QUERY option */
 	NumLiteral                      /* Num/Int/Float/Decimal Literal */
 	NoWriteToBinLogAliasOpt         /* NO_WRITE_TO_BINLOG alias LOCAL or empty */
 	ObjectType                      /* Grant statement object type */
@@ -811,8 +954,13 @@ import (
 	SelectStmtOpts                  /* Select statement options */
 	SelectStmtBasic                 /* SELECT statement from constant value */
 	SelectStmtFromDualTable         /* SELECT statement from dual table */
+	ServerOption                    /* Server definition option */
+	ServerOptionList                /* Server definition option list */
+	TableValueConstructor           /* VALUES table value constructor */
 	SelectStmtFromTable             /* SELECT statement from table */
 	SelectStmtGroup                 /* SELECT statement optional GROUP BY clause */
+	SetStatementVar                 /* Variable assignment of SET STATEMENT */
+	SetStatementVarList             /* Variable assignment list of SET STATEMENT */
 	SetRoleOpt                      /* Set role options */
 	SetDefaultRoleOpt               /* Set default role options */
 	ShowTargetFilterable            /* Show target that can be filtered by WHERE or LIKE */
@@ -840,6 +988,7 @@ import (
 	TableLock                       /* Table name and lock type */
 	TableLockList                   /* Table lock list */
 	TableName                       /* Table name */
+	ObjectName                      /* Stored routine, package, trigger or event name */
 	TableNameList                   /* Table name list */
 	TableNameListOpt                /* Table name list opt */
 	TableOption                     /* create table option */
@@ -865,6 +1014,7 @@ import (
 	ValuesOpt                       /* values optional */
 	VariableAssignment              /* set variable value */
 	VariableAssignmentList          /* set variable value list */
+	VectorIndexDef                  /* vector index definition */
 	ViewAlgorithm                   /* view algorithm */
 	ViewCheckOption                 /* view check option */
 	ViewDefiner                     /* view definer */
@@ -950,9 +1100,11 @@ import (
 	DefaultKwdOpt                   /* optional DEFAULT keyword */
 	DatabaseSym                     /* DATABASE or SCHEMA */
 	ExplainSym                      /* EXPLAIN or DESCRIBE or DESC */
+	ExplainFormat                   /* FORMAT = format_name for EXPLAIN, ANALYZE and SHOW EXPLAIN */
+	ExplainFormatName               /* Format name for EXPLAIN, ANALYZE and SHOW EXPLAIN */
+	XMLRowsIdentifiedOpt            /* LOAD XML ROWS IDENTIFIED BY option */
 	RegexpSym                       /* REGEXP or RLIKE */
 	IntoOpt                         /* INTO or EmptyString */
-	ValueSym                        /* Value or Values */
 	Varchar                         /* {NATIONAL VARCHAR|VARCHAR|NVARCHAR} */
 	TimeUnit                        /* Time unit for 'DATE_ADD', 'DATE_SUB', 'ADDDATE', 'SUBDATE', 'EXTRACT' */
 	TimestampUnit                   /* Time unit for 'TIMESTAMPADD' and 'TIMESTAMPDIFF' */
@@ -975,11 +1127,15 @@ import (
 	LinearOpt                       /* linear or empty */
 	FieldsOrColumns                 /* Fields or columns */
 	GetFormatSelector               /* {DATE|DATETIME|TIME|TIMESTAMP} */
+	AuthUsing                       /* USING or AS */
 
 
 %type	<ident>
 	ODBCDateTimeType                /* ODBC type keywords for date and time literals */
 	Identifier                      /* Identifier or unreserved keyword */
+	BlockKeyword                    /* BEGIN or END */
+	EndLabelOpt                     /* Name after the END of a stored program */
+	ProcedureVarName                /* Identifier which can name a stored program variable */
 	NotKeywordToken                 /* Tokens not mysql keyword but treated specially */
 	UnReservedKeyword               /* MySQL unreserved keywords */
 	FunctionNameConflict            /* Built-in function call names which are conflict with keywords */
@@ -1009,6 +1165,9 @@ import (
 %precedence charsetKwd
 %precedence lowerThanKey
 %precedence key
+%precedence rows
+%precedence higherThanRows
+%precedence lowerThanOrder
 
 
 %left       join straightJoin inner cross left right full natural
@@ -1034,6 +1193,9 @@ import (
 %right      collate
 
 
+/* In "(VALUES (a))" the VALUES() function wins over a one column table value constructor. */
+%precedence lowerThanRightParen
+%precedence ')'
 %precedence '('
 %precedence quick
 %precedence escape
@@ -1061,17 +1223,30 @@ AlterTableStmt:
 			Specs: $5.([]*ast.AlterTableSpec),
 		}
 	}
+|	"ALTER" IgnoreOptional "TABLE" "IF" "EXISTS" TableName AlterTableSpecList
+	{
+		$$ = &ast.AlterTableStmt{
+			IfExists: true,
+			Table:    $6.(*ast.TableName),
+			Specs:    $7.([]*ast.AlterTableSpec),
+		}
+	}
 |	"ALTER" IgnoreOptional "TABLE" TableName "ANALYZE" "PARTITION" PartitionNameList
 	{
-		$$ = &ast.AnalyzeTableStmt{TableNames: []*ast.TableName{$4.(*ast.TableName)}, PartitionNames: $7.([]model.CIStr) }
+		$$ = &ast.AnalyzeTableStmt{TableNames: []*ast.TableName{$4.(*ast.TableName)}, PartitionNames: $7.([]model.CIStr), AlterTable: true}
 	}
 |	"ALTER" IgnoreOptional "TABLE" TableName "ANALYZE" "PARTITION" PartitionNameList "INDEX" IndexNameList
 	{
+		if !parser.tidbAnalyze {
+			yylex.AppendError(yylex.Errorf("ALTER TABLE ... ANALYZE PARTITION ... INDEX is only supported when the TiDB form of ANALYZE is enabled"))
+			return 1
+		}
 		$$ = &ast.AnalyzeTableStmt{
 			TableNames: []*ast.TableName{$4.(*ast.TableName)},
 			PartitionNames: $7.([]model.CIStr),
 			IndexNames: $9.([]model.CIStr),
 			IndexFlag: true,
+			AlterTable: true,
 		}
 	}
 
@@ -1095,19 +1270,32 @@ AlterTableSpec:
 		}
 		$$ = op
 	}
-|	"ADD" ColumnKeywordOpt ColumnDef ColumnPosition
+|	"CONVERT" "TO" CharsetOrCharacterSet "DEFAULT" OptCollate
+	{
+		op := &ast.AlterTableSpec{
+			Tp: ast.AlterTableOption,
+			Options:[]*ast.TableOption{{Tp: ast.TableOptionCharset, Default: true}},
+		}
+		if $5 != "" {
+			op.Options = append(op.Options, &ast.TableOption{Tp: ast.TableOptionCollate, StrValue: $5.(string)})
+		}
+		$$ = op
+	}
+|	"ADD" ColumnKeywordOpt IfNotExists ColumnDef ColumnPosition
 	{
 		$$ = &ast.AlterTableSpec{
+			IfNotExists:	$3.(bool),
 			Tp: 		ast.AlterTableAddColumns,
-			NewColumns:	[]*ast.ColumnDef{$3.(*ast.ColumnDef)},
-			Position:	$4.(*ast.ColumnPosition),
+			NewColumns:	[]*ast.ColumnDef{$4.(*ast.ColumnDef)},
+			Position:	$5.(*ast.ColumnPosition),
 		}
 	}
-|	"ADD" ColumnKeywordOpt '(' ColumnDefList ')'
+|	"ADD" ColumnKeywordOpt IfNotExists '(' ColumnDefList ')'
 	{
 		$$ = &ast.AlterTableSpec{
+			IfNotExists:	$3.(bool),
 			Tp: 		ast.AlterTableAddColumns,
-			NewColumns:	$4.([]*ast.ColumnDef),
+			NewColumns:	$5.([]*ast.ColumnDef),
 		}
 	}
 |	"ADD" Constraint
@@ -1118,13 +1306,21 @@ AlterTableSpec:
 			Constraint: constraint,
 		}
 	}
-|	"ADD" "PARTITION" PartitionDefinitionListOpt
+|	"ADD" VectorIndexDef
+	{
+		$$ = &ast.AlterTableSpec{
+			Tp: ast.AlterTableAddConstraint,
+			Constraint: $2.(*ast.Constraint),
+		}
+	}
+|	"ADD" "PARTITION" IfNotExists PartitionDefinitionListOpt
 	{
 		var defs []*ast.PartitionDefinition
-		if $3 != nil {
-			defs = $3.([]*ast.PartitionDefinition)
+		if $4 != nil {
+			defs = $4.([]*ast.PartitionDefinition)
 		}
 		$$ = &ast.AlterTableSpec{
+			IfNotExists: $3.(bool),
 			Tp: ast.AlterTableAddPartitions,
 			PartDefinitions: defs,
 		}
@@ -1143,22 +1339,24 @@ AlterTableSpec:
 			Num: getUint64FromNUM($3),
 		}
 	}
-|	"DROP" ColumnKeywordOpt ColumnName RestrictOrCascadeOpt
+|	"DROP" ColumnKeywordOpt IfExists ColumnName RestrictOrCascadeOpt
 	{
 		$$ = &ast.AlterTableSpec{
+			IfExists: $3.(bool),
 			Tp: ast.AlterTableDropColumn,
-			OldColumnName: $3.(*ast.ColumnName),
+			OldColumnName: $4.(*ast.ColumnName),
 		}
 	}
 |	"DROP" "PRIMARY" "KEY"
 	{
 		$$ = &ast.AlterTableSpec{Tp: ast.AlterTableDropPrimaryKey}
 	}
-|	"DROP" "PARTITION" Identifier
+|	"DROP" "PARTITION" IfExists Identifier
 	{
 		$$ = &ast.AlterTableSpec{
+			IfExists: $3.(bool),
 			Tp: ast.AlterTableDropPartition,
-			Name: $3,
+			Name: $4,
 		}
 	}
 |	"TRUNCATE" "PARTITION" Identifier
@@ -1168,63 +1366,92 @@ AlterTableSpec:
 			Name: $3,
 		}
 	}
-|	"DROP" KeyOrIndex Identifier
+|	"DROP" KeyOrIndex IfExists Identifier
 	{
 		$$ = &ast.AlterTableSpec{
+			IfExists: $3.(bool),
 			Tp: ast.AlterTableDropIndex,
-			Name: $3,
+			Name: $4,
 		}
 	}
-|	"DROP" "FOREIGN" "KEY" Symbol
+|	"DROP" "FOREIGN" "KEY" IfExists Symbol
 	{
 		$$ = &ast.AlterTableSpec{
+			IfExists: $4.(bool),
 			Tp: ast.AlterTableDropForeignKey,
-			Name: $4.(string),
+			Name: $5.(string),
 		}
 	}
 |	"DISABLE" "KEYS"
 	{
-		$$ = &ast.AlterTableSpec{}
+		$$ = &ast.AlterTableSpec{
+			Tp: ast.AlterTableDisableKeys,
+		}
 	}
 |	"ENABLE" "KEYS"
 	{
-		$$ = &ast.AlterTableSpec{}
+		$$ = &ast.AlterTableSpec{
+			Tp: ast.AlterTableEnableKeys,
+		}
 	}
-|	"MODIFY" ColumnKeywordOpt ColumnDef ColumnPosition
+|	"DISCARD" "TABLESPACE"
 	{
 		$$ = &ast.AlterTableSpec{
-			Tp:		ast.AlterTableModifyColumn,
-			NewColumns:	[]*ast.ColumnDef{$3.(*ast.ColumnDef)},
-			Position:	$4.(*ast.ColumnPosition),
+			Tp: ast.AlterTableDiscardTablespace,
 		}
 	}
-|	"CHANGE" ColumnKeywordOpt ColumnName ColumnDef ColumnPosition
+|	"IMPORT" "TABLESPACE"
 	{
 		$$ = &ast.AlterTableSpec{
-			Tp:    		ast.AlterTableChangeColumn,
-			OldColumnName:	$3.(*ast.ColumnName),
+			Tp: ast.AlterTableImportTablespace,
+		}
+	}
+|	"ORDER" "BY" AlterOrderList %prec lowerThanOrder
+	{
+		$$ = &ast.AlterTableSpec{
+			Tp:		ast.AlterTableOrderByColumns,
+			OrderByList:	$3.([]*ast.AlterOrderItem),
+		}
+	}
+|	"MODIFY" ColumnKeywordOpt IfExists ColumnDef ColumnPosition
+	{
+		$$ = &ast.AlterTableSpec{
+			IfExists:	$3.(bool),
+			Tp:		ast.AlterTableModifyColumn,
 			NewColumns:	[]*ast.ColumnDef{$4.(*ast.ColumnDef)},
 			Position:	$5.(*ast.ColumnPosition),
 		}
 	}
-|	"ALTER" ColumnKeywordOpt ColumnName "SET" "DEFAULT" SignedLiteral
+|	"CHANGE" ColumnKeywordOpt IfExists ColumnName ColumnDef ColumnPosition
+	{
+		$$ = &ast.AlterTableSpec{
+			IfExists:	$3.(bool),
+			Tp:    		ast.AlterTableChangeColumn,
+			OldColumnName:	$4.(*ast.ColumnName),
+			NewColumns:	[]*ast.ColumnDef{$5.(*ast.ColumnDef)},
+			Position:	$6.(*ast.ColumnPosition),
+		}
+	}
+|	"ALTER" ColumnKeywordOpt IfExists ColumnName "SET" "DEFAULT" SignedLiteral
 	{
-		option := &ast.ColumnOption{Expr: $6}
+		option := &ast.ColumnOption{Expr: $7}
 		colDef := &ast.ColumnDef{
-			Name: 	 $3.(*ast.ColumnName),
+			Name: 	 $4.(*ast.ColumnName),
 			Options: []*ast.ColumnOption{option},
 		}
 		$$ = &ast.AlterTableSpec{
+			IfExists:	$3.(bool),
 			Tp:		ast.AlterTableAlterColumn,
 			NewColumns:	[]*ast.ColumnDef{colDef},
 		}
 	}
-|	"ALTER" ColumnKeywordOpt ColumnName "DROP" "DEFAULT"
+|	"ALTER" ColumnKeywordOpt IfExists ColumnName "DROP" "DEFAULT"
 	{
 		colDef := &ast.ColumnDef{
-			Name: 	 $3.(*ast.ColumnName),
+			Name: 	 $4.(*ast.ColumnName),
 		}
 		$$ = &ast.AlterTableSpec{
+			IfExists:	$3.(bool),
 			Tp:		ast.AlterTableAlterColumn,
 			NewColumns:	[]*ast.ColumnDef{colDef},
 		}
@@ -1250,12 +1477,13 @@ AlterTableSpec:
 			NewTable:      $3.(*ast.TableName),
 		}
 	}
-|	"RENAME" KeyOrIndex Identifier "TO" Identifier
+|	"RENAME" KeyOrIndex IfExists Identifier "TO" Identifier
 	{
 		$$ = &ast.AlterTableSpec{
+			IfExists:   $3.(bool),
 			Tp:    	    ast.AlterTableRenameIndex,
-			FromKey:    model.NewCIStr($3),
-			ToKey:      model.NewCIStr($5),
+			FromKey:    model.NewCIStr($4),
+			ToKey:      model.NewCIStr($6),
 		}
 	}
 |	LockClause
@@ -1265,12 +1493,12 @@ AlterTableSpec:
 			LockType:   $1.(ast.LockType),
 		}
 	}
-| "ALGORITHM" EqOpt AlterAlgorithm
+| AlgorithmClause
 	{
 		// Parse it and ignore it. Just for compatibility.
 		$$ = &ast.AlterTableSpec{
 			Tp:    		ast.AlterTableAlgorithm,
-			Algorithm:	$3.(ast.AlterAlgorithm),
+			Algorithm:	$1.(ast.AlterAlgorithm),
 		}
 	}
 | "FORCE"
@@ -1282,23 +1510,96 @@ AlterTableSpec:
 	}
 
 
+AlterOrderList:
+	AlterOrderItem
+	{
+		$$ = []*ast.AlterOrderItem{$1.(*ast.AlterOrderItem)}
+	}
+|	AlterOrderList ',' AlterOrderItem
+	{
+		$$ = append($1.([]*ast.AlterOrderItem), $3.(*ast.AlterOrderItem))
+	}
+
+
+AlterOrderItem:
+	ColumnName Order
+	{
+		$$ = &ast.AlterOrderItem{Column: $1.(*ast.ColumnName), Desc: $2.(bool)}
+	}
+
+
+AlgorithmClause:
+	"ALGORITHM" EqOpt AlterAlgorithm
+	{
+		$$ = $3
+	}
+
+
 AlterAlgorithm:
-	"DEFAULT" { $$ = ast.AlterAlgorithmDefault }
-|	"COPY"    { $$ = ast.AlterAlgorithmCopy }
-|	"INPLACE" { $$ = ast.AlterAlgorithmInplace }
-|	"INSTANT" { $$ = ast.AlterAlgorithmInstant }
+	"DEFAULT"
+	{
+		$$ = ast.AlterAlgorithmDefault
+	}
+|	Identifier
+	{
+		switch strings.ToUpper($1) {
+		case "COPY":
+			$$ = ast.AlterAlgorithmCopy
+		case "INPLACE":
+			$$ = ast.AlterAlgorithmInplace
+		case "INSTANT":
+			$$ = ast.AlterAlgorithmInstant
+		case "NOCOPY":
+			$$ = ast.AlterAlgorithmNocopy
+		default:
+			yylex.AppendError(ErrUnknownAlterAlgorithm.GenWithStackByArgs($1))
+			return 1
+		}
+	}
 
 
-LockClauseOpt:
-	/* EMPTY */ {}
-|	LockClause  {}
+LockAndAlgorithmOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	LockClause
+	{
+		$$ = &ast.LockAndAlgorithm{LockType: $1.(ast.LockType)}
+	}
+|	AlgorithmClause
+	{
+		$$ = &ast.LockAndAlgorithm{Algorithm: $1.(ast.AlterAlgorithm), HasAlgorithm: true}
+	}
+|	LockClause AlgorithmClause
+	{
+		$$ = &ast.LockAndAlgorithm{LockType: $1.(ast.LockType), Algorithm: $2.(ast.AlterAlgorithm), HasAlgorithm: true}
+	}
+|	AlgorithmClause LockClause
+	{
+		$$ = &ast.LockAndAlgorithm{LockType: $2.(ast.LockType), Algorithm: $1.(ast.AlterAlgorithm), HasAlgorithm: true}
+	}
 
 
 LockClause:
-	"LOCK" eq "NONE"      { $$ = ast.LockTypeNone }
-|	"LOCK" eq "DEFAULT"   { $$ = ast.LockTypeDefault }
-|	"LOCK" eq "SHARED"    { $$ = ast.LockTypeShared }
-|	"LOCK" eq "EXCLUSIVE" { $$ = ast.LockTypeExclusive }
+	"LOCK" EqOpt "DEFAULT"
+	{
+		$$ = ast.LockTypeDefault
+	}
+|	"LOCK" EqOpt Identifier
+	{
+		switch strings.ToUpper($3) {
+		case "NONE":
+			$$ = ast.LockTypeNone
+		case "SHARED":
+			$$ = ast.LockTypeShared
+		case "EXCLUSIVE":
+			$$ = ast.LockTypeExclusive
+		default:
+			yylex.AppendError(ErrUnknownAlterLock.GenWithStackByArgs($3))
+			return 1
+		}
+	}
 
 
 KeyOrIndex: "KEY" | "INDEX"
@@ -1314,6 +1615,33 @@ ColumnKeywordOpt:
 |	"COLUMN"
 
 
+/*
+ * A vector index can't be named by CONSTRAINT. VECTOR is not reserved, so the lexer
+ * tells "ALTER TABLE t ADD vector INDEX ..." from a column named vector by the KEY or
+ * INDEX after it.
+ * See https://mariadb.com/kb/en/create-table-with-vectors/
+ */
+VectorIndexDef:
+	vectorIndex KeyOrIndex IfNotExists IndexName '(' IndexColNameList ')' IndexOptionList
+	{
+		parser.checkFeature(FeatureVector, parser.startOffset(&yyS[yypt-7]))
+		c := &ast.Constraint{
+			IfNotExists:	$3.(bool),
+			Tp:		ast.ConstraintVector,
+			Keys:		$6.([]*ast.IndexColName),
+			Name:		$4.(string),
+		}
+		if $8 != nil {
+			c.Option = $8.(*ast.IndexOption)
+			if err := setVectorIndexOption(c.Option); err != nil {
+				yylex.AppendError(err)
+				return 1
+			}
+		}
+		$$ = c
+	}
+
+
 ColumnPosition:
 	{
 		$$ = &ast.ColumnPosition{Tp: ast.ColumnPositionNone}
@@ -1369,6 +1697,30 @@ Symbol:
  * TODO: refactor this when you are going to add full support for multiple schema changes.
  * Currently it is only useful for syncer which depends heavily on tidb parser to do some dirty work.
  *******************************************************************************************/
+RenameUserStmt:
+	"RENAME" "USER" UserToUserList
+	{
+		$$ = &ast.RenameUserStmt{
+			UserToUsers: $3.([]*ast.UserToUser),
+		}
+	}
+
+
+UserToUserList:
+	UserToUser                    { $$ = []*ast.UserToUser{$1.(*ast.UserToUser)} }
+|	UserToUserList ',' UserToUser { $$ = append($1.([]*ast.UserToUser), $3.(*ast.UserToUser)) }
+
+
+UserToUser:
+	Username "TO" Username
+	{
+		$$ = &ast.UserToUser{
+			OldUser: $1.(*auth.UserIdentity),
+			NewUser: $3.(*auth.UserIdentity),
+		}
+	}
+
+
 RenameTableStmt:
 	"RENAME" "TABLE" TableToTableList
 	{
@@ -1404,29 +1756,117 @@ TableToTable:
 /*******************************************************************************************/
 
 AnalyzeTableStmt:
-	"ANALYZE" "TABLE" TableNameList
+	"ANALYZE" NoWriteToBinLogAliasOpt "TABLE" TableNameList AnalyzePersistentForOpt
 	 {
-		$$ = &ast.AnalyzeTableStmt{ TableNames: $3.([]*ast.TableName) }
+		x := &ast.AnalyzeTableStmt{NoWriteToBinLog: $2.(bool), TableNames: $4.([]*ast.TableName)}
+		if $5 != nil {
+			x.PersistentFor = $5.(*ast.AnalyzePersistentFor)
+		}
+		$$ = x
 	 }
-|	"ANALYZE" "TABLE" TableName "INDEX" IndexNameList
+|	"ANALYZE" NoWriteToBinLogAliasOpt "TABLE" TableName "INDEX" IndexNameList
 	{
-		$$ = &ast.AnalyzeTableStmt{ TableNames: []*ast.TableName{$3.(*ast.TableName)}, IndexNames: $5.([]model.CIStr), IndexFlag: true }
+		if !parser.tidbAnalyze {
+			yylex.AppendError(yylex.Errorf("ANALYZE TABLE ... INDEX is only supported when the TiDB form of ANALYZE is enabled"))
+			return 1
+		}
+		$$ = &ast.AnalyzeTableStmt{NoWriteToBinLog: $2.(bool), TableNames: []*ast.TableName{$4.(*ast.TableName)}, IndexNames: $6.([]model.CIStr), IndexFlag: true}
 	}
-|	"ANALYZE" "TABLE" TableName "PARTITION" PartitionNameList
+|	"ANALYZE" NoWriteToBinLogAliasOpt "TABLE" TableName "PARTITION" PartitionNameList
 	{
-		$$ = &ast.AnalyzeTableStmt{ TableNames: []*ast.TableName{$3.(*ast.TableName)}, PartitionNames: $5.([]model.CIStr) }
+		if !parser.tidbAnalyze {
+			yylex.AppendError(yylex.Errorf("ANALYZE TABLE ... PARTITION is only supported when the TiDB form of ANALYZE is enabled"))
+			return 1
+		}
+		$$ = &ast.AnalyzeTableStmt{NoWriteToBinLog: $2.(bool), TableNames: []*ast.TableName{$4.(*ast.TableName)}, PartitionNames: $6.([]model.CIStr)}
 	}
-|	"ANALYZE" "TABLE" TableName "PARTITION" PartitionNameList "INDEX" IndexNameList
+|	"ANALYZE" NoWriteToBinLogAliasOpt "TABLE" TableName "PARTITION" PartitionNameList "INDEX" IndexNameList
 	{
+		if !parser.tidbAnalyze {
+			yylex.AppendError(yylex.Errorf("ANALYZE TABLE ... PARTITION is only supported when the TiDB form of ANALYZE is enabled"))
+			return 1
+		}
 		$$ = &ast.AnalyzeTableStmt{
-			TableNames: []*ast.TableName{$3.(*ast.TableName)},
-			PartitionNames: $5.([]model.CIStr),
-			IndexNames: $7.([]model.CIStr),
+			NoWriteToBinLog: $2.(bool),
+			TableNames: []*ast.TableName{$4.(*ast.TableName)},
+			PartitionNames: $6.([]model.CIStr),
+			IndexNames: $8.([]model.CIStr),
 			IndexFlag: true,
 		}
 	}
 
 
+/*
+ * Engine-independent statistics, see https://mariadb.com/kb/en/analyze-table/
+ * ALL stands for all columns or indexes, and an empty list for none of them.
+ */
+AnalyzePersistentForOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	"PERSISTENT" "FOR" "ALL"
+	{
+		$$ = &ast.AnalyzePersistentFor{All: true}
+	}
+|	"PERSISTENT" "FOR" "COLUMNS" AnalyzeColumnsSpec "INDEXES" AnalyzeIndexesSpec
+	{
+		x := &ast.AnalyzePersistentFor{}
+		if $4 == nil {
+			x.AllColumns = true
+		} else {
+			x.Columns = $4.([]model.CIStr)
+		}
+		if $6 == nil {
+			x.AllIndexes = true
+		} else {
+			x.Indexes = $6.([]model.CIStr)
+		}
+		$$ = x
+	}
+
+
+AnalyzeColumnsSpec:
+	"ALL"
+	{
+		$$ = nil
+	}
+|	'(' ')'
+	{
+		$$ = []model.CIStr{}
+	}
+|	'(' AnalyzeColumnList ')'
+	{
+		$$ = $2
+	}
+
+
+AnalyzeColumnList:
+	Identifier
+	{
+		$$ = []model.CIStr{model.NewCIStr($1)}
+	}
+|	AnalyzeColumnList ',' Identifier
+	{
+		$$ = append($1.([]model.CIStr), model.NewCIStr($3))
+	}
+
+
+AnalyzeIndexesSpec:
+	"ALL"
+	{
+		$$ = nil
+	}
+|	'(' IndexNameList ')'
+	{
+		names := $2.([]model.CIStr)
+		if names == nil {
+			names = []model.CIStr{}
+		}
+		$$ = names
+	}
+
+
 /*******************************************************************************************/
 Assignment:
 	ColumnName eq Expression
@@ -1480,11 +1920,26 @@ ColumnDef:
 			yylex.AppendError(yylex.Errorf("Invalid column definition"))
 			return 1
 		}
+		options := colDef.Options[:0]
 		for _, opt := range colDef.Options {
-			if opt.Tp != ast.ColumnOptionCollate {
+			switch opt.Tp {
+			case ast.ColumnOptionCollate:
+				colDef.Tp.Collate = opt.StrValue
+			case ast.ColumnOptionCompressed:
+				// COMPRESSED is an attribute of the column type, see https://mariadb.com/kb/en/storage-engine-independent-column-compression/
+				if !types.IsTypeBlob(colDef.Tp.Tp) && !types.IsTypeVarchar(colDef.Tp.Tp) {
+					yylex.AppendError(ErrWrongFieldSpec.GenWithStackByArgs(colDef.Name.Name.O))
+					return 1
+				}
+				colDef.Tp.Compressed = true
 				continue
 			}
-			colDef.Tp.Collate = opt.StrValue
+			options = append(options, opt)
+		}
+		colDef.Options = options
+		if colDef.Tp.Tp == mysql.TypeVector && (colDef.Tp.Flen < 1 || colDef.Tp.Flen > types.MaxVectorDimension) {
+			yylex.AppendError(ErrWrongFieldSpec.GenWithStackByArgs(colDef.Name.Name.O))
+			return 1
 		}
 		$$ = colDef
 	}
@@ -1632,6 +2087,55 @@ ColumnOption:
 	{
 		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionCollate, StrValue: $2.(string)}
 	}
+|	EngineAttribute
+	{
+		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionEngineAttribute, EngineAttribute: $1.(*model.EngineAttribute)}
+	}
+|	"COMPRESSED"
+	{
+		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionCompressed}
+	}
+|	"COMPRESSED" eq Identifier
+	{
+		// zlib is the only compression method.
+		if !strings.EqualFold($3, "zlib") {
+			yylex.AppendError(yylex.Errorf("Unknown compression method: %s", $3))
+			return 1
+		}
+		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionCompressed}
+	}
+
+
+/*
+ * Storage engine defined table, column and index options.
+ * See https://mariadb.com/kb/en/engine-defined-new-tablefieldindex-attributes/
+ */
+EngineAttribute:
+	identifier eq EngineAttributeValue
+	{
+		attr := $3.(*model.EngineAttribute)
+		attr.Name = $1
+		$$ = attr
+	}
+
+
+EngineAttributeValue:
+	stringLit
+	{
+		$$ = &model.EngineAttribute{Tp: model.EngineAttributeValueString, Value: $1}
+	}
+|	LengthNum
+	{
+		$$ = &model.EngineAttribute{Tp: model.EngineAttributeValueNumber, Value: strconv.FormatUint($1.(uint64), 10)}
+	}
+|	Identifier
+	{
+		$$ = &model.EngineAttribute{Tp: model.EngineAttributeValueIdent, Value: $1}
+	}
+|	"DEFAULT"
+	{
+		$$ = &model.EngineAttribute{Tp: model.EngineAttributeValueDefault}
+	}
 
 GeneratedAlways:
 	/* EMPTY */
@@ -1672,61 +2176,65 @@ ConstraintElem:
 		}
 		$$ = c
 	}
-|	"FULLTEXT" KeyOrIndexOpt IndexName '(' IndexColNameList ')' IndexOptionList
+|	"FULLTEXT" KeyOrIndexOpt IfNotExists IndexName '(' IndexColNameList ')' IndexOptionList
 	{
 		c := &ast.Constraint{
-			Tp:	ast.ConstraintFulltext,
-			Keys:	$5.([]*ast.IndexColName),
-			Name:	$3.(string),
+			IfNotExists:	$3.(bool),
+			Tp:		ast.ConstraintFulltext,
+			Keys:		$6.([]*ast.IndexColName),
+			Name:		$4.(string),
 		}
-		if $7 != nil {
-			c.Option = $7.(*ast.IndexOption)
+		if $8 != nil {
+			c.Option = $8.(*ast.IndexOption)
 		}
 		$$ = c
 	}
-|	KeyOrIndex IndexName IndexTypeOpt '(' IndexColNameList ')' IndexOptionList
+|	KeyOrIndex IfNotExists IndexName IndexTypeOpt '(' IndexColNameList ')' IndexOptionList
 	{
 		c := &ast.Constraint{
-			Tp:	ast.ConstraintIndex,
-			Keys:	$5.([]*ast.IndexColName),
-			Name:	$2.(string),
+			IfNotExists:	$2.(bool),
+			Tp:		ast.ConstraintIndex,
+			Keys:		$6.([]*ast.IndexColName),
+			Name:		$3.(string),
 		}
-		if $7 != nil {
-			c.Option = $7.(*ast.IndexOption)
+		if $8 != nil {
+			c.Option = $8.(*ast.IndexOption)
 		}
-		if $3 != nil {
+		if $4 != nil {
 			if c.Option == nil {
 				c.Option = &ast.IndexOption{}
 			}
-			c.Option.Tp = $3.(model.IndexType)
+			c.Option.Tp = $4.(model.IndexType)
 		}
 		$$ = c
 	}
-|	"UNIQUE" KeyOrIndexOpt IndexName IndexTypeOpt '(' IndexColNameList ')' IndexOptionList
+|	"UNIQUE" KeyOrIndexOpt IfNotExists IndexName IndexTypeOpt '(' IndexColNameList ')' IndexOptionList
 	{
 		c := &ast.Constraint{
-			Tp:	ast.ConstraintUniq,
-			Keys:	$6.([]*ast.IndexColName),
-			Name:	$3.(string),
+			IfNotExists:	$3.(bool),
+			Tp:		ast.ConstraintUniq,
+			Keys:		$7.([]*ast.IndexColName),
+			Name:		$4.(string),
 		}
-		if $8 != nil {
-			c.Option = $8.(*ast.IndexOption)
+		if $9 != nil {
+			c.Option = $9.(*ast.IndexOption)
 		}
-		if $4 != nil {
+		if $5 != nil {
 			if c.Option == nil {
 				c.Option = &ast.IndexOption{}
 			}
-			c.Option.Tp = $4.(model.IndexType)
+			c.Option.Tp = $5.(model.IndexType)
 		}
 		$$ = c
 	}
-|	"FOREIGN" "KEY" IndexName '(' IndexColNameList ')' ReferDef
+|	"FOREIGN" "KEY" IfNotExists IndexName '(' IndexColNameList ')' ReferDef
 	{
 		$$ = &ast.Constraint{
-			Tp:	ast.ConstraintForeignKey,
-			Keys:	$5.([]*ast.IndexColName),
-			Name:	$3.(string),
-			Refer:	$7.(*ast.ReferenceDef),
+			IfNotExists:	$3.(bool),
+			Tp:		ast.ConstraintForeignKey,
+			Keys:		$6.([]*ast.IndexColName),
+			Name:		$4.(string),
+			Refer:		$8.(*ast.ReferenceDef),
 		}
 	}
 
@@ -1820,97 +2328,569 @@ NumLiteral:
 
 
 CreateIndexStmt:
-	"CREATE" CreateIndexStmtUnique "INDEX" Identifier IndexTypeOpt "ON" TableName '(' IndexColNameList ')' IndexOptionList LockClauseOpt
+	"CREATE" OrReplace CreateIndexStmtUnique "INDEX" Identifier IndexTypeOpt "ON" TableName '(' IndexColNameList ')' IndexOptionList LockAndAlgorithmOpt
+	{
+		if !parser.checkOrReplace($2.(bool), false, FeatureCreateOrReplaceIndex, parser.startOffset(&yyS[yypt-11])) {
+			return 1
+		}
+		var indexOption *ast.IndexOption
+		if $12 != nil {
+			indexOption = $12.(*ast.IndexOption)
+			if indexOption.Tp == model.IndexTypeInvalid {
+				if $6 != nil {
+					indexOption.Tp = $6.(model.IndexType)
+				}
+			}
+		} else {
+			indexOption = &ast.IndexOption{}
+			if $6 != nil {
+				indexOption.Tp = $6.(model.IndexType)
+			}
+		}
+		x := &ast.CreateIndexStmt{
+			OrReplace:     $2.(bool),
+			Unique:        $3.(bool),
+			IndexName:     $5,
+			Table:         $8.(*ast.TableName),
+			IndexColNames: $10.([]*ast.IndexColName),
+			IndexOption:   indexOption,
+		}
+		if $13 != nil {
+			x.LockAlg = $13.(*ast.LockAndAlgorithm)
+		}
+		$$ = x
+	}
+|	"CREATE" OrReplace vectorIndex "INDEX" Identifier "ON" TableName '(' IndexColNameList ')' IndexOptionList LockAndAlgorithmOpt
+	{
+		if !parser.checkOrReplace($2.(bool), false, FeatureCreateOrReplaceIndex, parser.startOffset(&yyS[yypt-10])) {
+			return 1
+		}
+		parser.checkFeature(FeatureVector, parser.startOffset(&yyS[yypt-9]))
+		indexOption := &ast.IndexOption{}
+		if $11 != nil {
+			indexOption = $11.(*ast.IndexOption)
+			if err := setVectorIndexOption(indexOption); err != nil {
+				yylex.AppendError(err)
+				return 1
+			}
+		}
+		x := &ast.CreateIndexStmt{
+			OrReplace:     $2.(bool),
+			Vector:        true,
+			IndexName:     $5,
+			Table:         $7.(*ast.TableName),
+			IndexColNames: $9.([]*ast.IndexColName),
+			IndexOption:   indexOption,
+		}
+		if $12 != nil {
+			x.LockAlg = $12.(*ast.LockAndAlgorithm)
+		}
+		$$ = x
+	}
+
+
+CreateIndexStmtUnique:
+	/* EMPTY */ { $$ = false }
+|	"UNIQUE"    { $$ = true }
+
+
+IndexColName:
+	ColumnName OptFieldLen Order
+	{
+		//Order is parsed but just ignored as MySQL did
+		$$ = &ast.IndexColName{Column: $1.(*ast.ColumnName), Length: $2.(int)}
+	}
+
+
+IndexColNameList:
+	IndexColName                      { $$ = []*ast.IndexColName{$1.(*ast.IndexColName)} }
+|	IndexColNameList ',' IndexColName { $$ = append($1.([]*ast.IndexColName), $3.(*ast.IndexColName)) }
+
+
+/**************************************AlterDatabaseStmt***************************************
+ * See https://dev.mysql.com/doc/refman/5.7/en/alter-database.html
+ * 'ALTER DATABASE ... UPGRADE DATA DIRECTORY NAME' is not supported yet.
+ *
+ *  ALTER {DATABASE | SCHEMA} [db_name]
+ *   alter_specification ...
+ *
+ *  alter_specification:
+ *   [DEFAULT] CHARACTER SET [=] charset_name
+ * | [DEFAULT] COLLATE [=] collation_name
+ * | COMMENT [=] 'comment'
+ *
+ *  ALTER {DATABASE | SCHEMA} db_name UPGRADE DATA DIRECTORY NAME
+ *******************************************************************************************/
+AlterDatabaseStmt:
+	"ALTER" DatabaseSym DBName DatabaseOptionList
+	{
+		$$ = &ast.AlterDatabaseStmt{
+			Name:                 $3.(string),
+			AlterDefaultDatabase: false,
+			Options:              $4.([]*ast.DatabaseOption),
+		}
+	}
+|	"ALTER" DatabaseSym DatabaseOptionList
+	{
+		$$ = &ast.AlterDatabaseStmt{
+			Name:                 "",
+			AlterDefaultDatabase: true,
+			Options:              $3.([]*ast.DatabaseOption),
+		}
+	}
+|	"ALTER" DatabaseSym DBName "UPGRADE" "DATA" "DIRECTORY" "NAME"
+	{
+		$$ = &ast.AlterDatabaseStmt{
+			Name:                     $3.(string),
+			UpgradeDataDirectoryName: true,
+		}
+	}
+
+
+/*******************************************************************
+ *
+ *  Create Binding Statement
+ *  CREATE [GLOBAL | SESSION] BINDING FOR select_stmt USING hinted_select_stmt
+ *
+ *  The hinted query must normalize to the same digest as the original query.
+ *******************************************************************/
+CreateBindingStmt:
+	"CREATE" GlobalScope "BINDING" "FOR" SelectStmt "USING" SelectStmt
+	{
+		startOffset := parser.startOffset(&yyS[yypt-2])
+		endOffset := parser.endOffset(&yyS[yypt-1])
+		originSel := $5.(*ast.SelectStmt)
+		originSel.SetText(strings.TrimSpace(parser.src[startOffset:endOffset]))
+
+		startOffset = parser.startOffset(&yyS[yypt])
+		endOffset = parser.endOffset(&parser.yylval)
+		hintedSel := $7.(*ast.SelectStmt)
+		hintedSel.SetText(strings.TrimSpace(parser.src[startOffset:endOffset]))
+
+		_, originDigest := NormalizeDigest(originSel.Text())
+		_, hintedDigest := NormalizeDigest(hintedSel.Text())
+		if originDigest != hintedDigest {
+			yylex.AppendError(ErrBindingMismatch)
+			return 1
+		}
+		$$ = &ast.CreateBindingStmt{
+			GlobalScope:	$2.(bool),
+			OriginSel:	originSel,
+			HintedSel:	hintedSel,
+		}
+	}
+
+
+DropBindingStmt:
+	"DROP" GlobalScope "BINDING" "FOR" SelectStmt
+	{
+		startOffset := parser.startOffset(&yyS[yypt])
+		endOffset := parser.endOffset(&parser.yylval)
+		originSel := $5.(*ast.SelectStmt)
+		originSel.SetText(strings.TrimSpace(parser.src[startOffset:endOffset]))
+		$$ = &ast.DropBindingStmt{
+			GlobalScope:	$2.(bool),
+			OriginSel:	originSel,
+		}
+	}
+
+
+/*******************************************************************
+ *
+ *  Create Procedure Statement, in sql_mode=ORACLE
+ *  CREATE [OR REPLACE] PROCEDURE sp_name [(param [, param] ...)] {AS | IS}
+ *      [var_name type [{:= | DEFAULT} expr]; ...]
+ *  BEGIN
+ *      statement; ...
+ *  [EXCEPTION
+ *      WHEN exception_name [OR exception_name] ... THEN statement; ... ...]
+ *  END [sp_name]
+ *
+ *  param:
+ *      param_name [IN | OUT | IN OUT | INOUT] type
+ *
+ *  type:
+ *      data_type | [table_name.]column_name%TYPE | table_name%ROWTYPE
+ *
+ *  See https://mariadb.com/kb/en/create-procedure/
+ *******************************************************************/
+CreateProcedureStmt:
+	"CREATE" OrReplace "PROCEDURE" ObjectName ProcedureParamListOpt AsOrIs ProcedureDeclListOpt ProcedureBlockBody EndLabelOpt
+	{
+		name := $4.(*ast.ObjectName)
+		if !parser.checkStoredProgram("CREATE PROCEDURE", name.Name.O, $9) {
+			return 1
+		}
+		parser.checkFeature(FeatureOracleProcedures, parser.startOffset(&yyS[yypt-6]))
+		block := $8.(*ast.ProcedureBlock)
+		block.Decls = $7.([]*ast.ProcedureDecl)
+		$$ = &ast.CreateProcedureStmt{
+			OrReplace: $2.(bool),
+			Name:      name,
+			Params:    $5.([]*ast.ProcedureParam),
+			UseIs:     $6.(bool),
+			Block:     block,
+		}
+	}
+
+
+/*******************************************************************
+ *
+ *  Create Package Statement, in sql_mode=ORACLE
+ *  CREATE [OR REPLACE] PACKAGE [BODY] package_name {AS | IS}
+ *      [var_name type [{:= | DEFAULT} expr]; ...]
+ *      [PROCEDURE sp_name [(param [, param] ...)]
+ *          [{AS | IS} [var_decl; ...] BEGIN statement; ... END [sp_name]]; ...]
+ *  END [package_name]
+ *
+ *  The procedures of a package specification have no body.
+ *  See https://mariadb.com/kb/en/create-package/
+ *******************************************************************/
+CreatePackageStmt:
+	"CREATE" OrReplace "PACKAGE" ObjectName AsOrIs PackageItemListOpt "END" EndLabelOpt
+	{
+		name := $4.(*ast.ObjectName)
+		if !parser.checkStoredProgram("CREATE PACKAGE", name.Name.O, $8) {
+			return 1
+		}
+		parser.checkFeature(FeaturePackages, parser.startOffset(&yyS[yypt-5]))
+		x := $6.(*ast.CreatePackageStmt)
+		for _, procedure := range x.Procedures {
+			if procedure.Block != nil {
+				yylex.AppendError(yylex.Errorf("The procedures of a package specification can't have a body"))
+				return 1
+			}
+		}
+		x.OrReplace = $2.(bool)
+		x.Name = name
+		x.UseIs = $5.(bool)
+		$$ = x
+	}
+|	"CREATE" OrReplace "PACKAGE" "BODY" ObjectName AsOrIs PackageItemListOpt "END" EndLabelOpt
+	{
+		name := $5.(*ast.ObjectName)
+		if !parser.checkStoredProgram("CREATE PACKAGE BODY", name.Name.O, $9) {
+			return 1
+		}
+		parser.checkFeature(FeaturePackages, parser.startOffset(&yyS[yypt-6]))
+		x := $7.(*ast.CreatePackageStmt)
+		x.OrReplace = $2.(bool)
+		x.IsBody = true
+		x.Name = name
+		x.UseIs = $6.(bool)
+		$$ = x
+	}
+
+
+PackageItemListOpt:
+	/* EMPTY */
+	{
+		$$ = &ast.CreatePackageStmt{Decls: []*ast.ProcedureDecl{}, Procedures: []*ast.PackageProcedure{}}
+	}
+|	PackageItemListOpt ProcedureDecl ';'
+	{
+		x := $1.(*ast.CreatePackageStmt)
+		x.Decls = append(x.Decls, $2.(*ast.ProcedureDecl))
+		$$ = x
+	}
+|	PackageItemListOpt PackageProcedure ';'
+	{
+		x := $1.(*ast.CreatePackageStmt)
+		x.Procedures = append(x.Procedures, $2.(*ast.PackageProcedure))
+		$$ = x
+	}
+
+
+PackageProcedure:
+	"PROCEDURE" ProcedureVarName ProcedureParamListOpt
+	{
+		$$ = &ast.PackageProcedure{Name: model.NewCIStr($2), Params: $3.([]*ast.ProcedureParam)}
+	}
+|	"PROCEDURE" ProcedureVarName ProcedureParamListOpt AsOrIs ProcedureDeclListOpt ProcedureBlockBody EndLabelOpt
+	{
+		if !parser.checkEndLabel($2, $7) {
+			return 1
+		}
+		block := $6.(*ast.ProcedureBlock)
+		block.Decls = $5.([]*ast.ProcedureDecl)
+		$$ = &ast.PackageProcedure{Name: model.NewCIStr($2), Params: $3.([]*ast.ProcedureParam), UseIs: $4.(bool), Block: block}
+	}
+
+
+AsOrIs:
+	"AS" { $$ = false }
+|	"IS" { $$ = true }
+
+
+EndLabelOpt:
+	/* EMPTY */ { $$ = "" }
+|	Identifier  { $$ = $1 }
+
+
+ProcedureParamListOpt:
+	/* EMPTY */                    { $$ = []*ast.ProcedureParam{} }
+|	'(' ')'                        { $$ = []*ast.ProcedureParam{} }
+|	'(' ProcedureParamList ')'     { $$ = $2.([]*ast.ProcedureParam) }
+
+
+ProcedureParamList:
+	ProcedureParam
+	{
+		$$ = []*ast.ProcedureParam{$1.(*ast.ProcedureParam)}
+	}
+|	ProcedureParamList ',' ProcedureParam
+	{
+		$$ = append($1.([]*ast.ProcedureParam), $3.(*ast.ProcedureParam))
+	}
+
+
+ProcedureParam:
+	ProcedureVarName ProcedureParamMode ProcedureParamType
+	{
+		$$ = &ast.ProcedureParam{Name: model.NewCIStr($1), Mode: $2.(ast.ProcedureParamMode), Tp: $3.(*ast.VariableType)}
+	}
+
+
+/* A parameter may leave out the length of its string type. */
+ProcedureParamType:
+	VariableType
+	{
+		$$ = $1
+	}
+|	Varchar OptBinary
+	{
+		x := types.NewFieldType(mysql.TypeVarchar)
+		x.Charset = $2.(*ast.OptBinary).Charset
+		if $2.(*ast.OptBinary).IsBinary {
+			x.Flag |= mysql.BinaryFlag
+		}
+		$$ = &ast.VariableType{Tp: x}
+	}
+|	"VARCHAR2" OptBinary
+	{
+		x := types.NewFieldType(mysql.TypeVarchar)
+		x.Charset = $2.(*ast.OptBinary).Charset
+		if $2.(*ast.OptBinary).IsBinary {
+			x.Flag |= mysql.BinaryFlag
+		}
+		$$ = &ast.VariableType{Tp: x}
+	}
+|	"VARBINARY"
+	{
+		x := types.NewFieldType(mysql.TypeVarchar)
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CharsetBin
+		x.Flag |= mysql.BinaryFlag
+		$$ = &ast.VariableType{Tp: x}
+	}
+|	"RAW"
+	{
+		x := types.NewFieldType(mysql.TypeVarchar)
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CharsetBin
+		x.Flag |= mysql.BinaryFlag
+		$$ = &ast.VariableType{Tp: x}
+	}
+
+
+ProcedureParamMode:
+	/* EMPTY */ { $$ = ast.ProcedureParamIn }
+|	"IN"        { $$ = ast.ProcedureParamIn }
+|	"OUT"       { $$ = ast.ProcedureParamOut }
+|	"IN" "OUT"  { $$ = ast.ProcedureParamInOut }
+|	"INOUT"     { $$ = ast.ProcedureParamInOut }
+
+
+VariableType:
+	Type
+	{
+		$$ = &ast.VariableType{Tp: $1.(*types.FieldType)}
+	}
+|	Identifier percentType
+	{
+		$$ = &ast.VariableType{AnchorColumn: &ast.ColumnName{Name: model.NewCIStr($1)}}
+	}
+|	Identifier '.' Identifier percentType
+	{
+		$$ = &ast.VariableType{AnchorColumn: &ast.ColumnName{Table: model.NewCIStr($1), Name: model.NewCIStr($3)}}
+	}
+|	TableName percentRowType
+	{
+		$$ = &ast.VariableType{AnchorTable: $1.(*ast.TableName)}
+	}
+
+
+ProcedureDeclListOpt:
+	/* EMPTY */
+	{
+		$$ = []*ast.ProcedureDecl{}
+	}
+|	ProcedureDeclListOpt ProcedureDecl ';'
+	{
+		$$ = append($1.([]*ast.ProcedureDecl), $2.(*ast.ProcedureDecl))
+	}
+
+
+ProcedureDecl:
+	ProcedureVarName VariableType
+	{
+		$$ = &ast.ProcedureDecl{Name: model.NewCIStr($1), Tp: $2.(*ast.VariableType)}
+	}
+|	ProcedureVarName VariableType assignmentEq Expression
+	{
+		$$ = &ast.ProcedureDecl{Name: model.NewCIStr($1), Tp: $2.(*ast.VariableType), Default: $4}
+	}
+|	ProcedureVarName VariableType "DEFAULT" Expression
+	{
+		$$ = &ast.ProcedureDecl{Name: model.NewCIStr($1), Tp: $2.(*ast.VariableType), Default: $4}
+	}
+
+
+ProcedureBlockBody:
+	"BEGIN" ProcedureStmtList ProcedureExceptionOpt "END"
+	{
+		$$ = &ast.ProcedureBlock{
+			Decls:    []*ast.ProcedureDecl{},
+			Stmts:    $2.([]ast.StmtNode),
+			Handlers: $3.([]*ast.ProcedureExceptionHandler),
+		}
+	}
+
+
+ProcedureStmtList:
+	ProcedureStmt ';'
+	{
+		$$ = []ast.StmtNode{$1.(ast.StmtNode)}
+	}
+|	ProcedureStmtList ProcedureStmt ';'
+	{
+		$$ = append($1.([]ast.StmtNode), $2.(ast.StmtNode))
+	}
+
+
+ProcedureStmt:
+	SelectStmt
+	{
+		$$ = $1
+	}
+|	UnionStmt
+	{
+		$$ = $1
+	}
+|	InsertIntoStmt
+	{
+		$$ = $1
+	}
+|	ReplaceIntoStmt
+	{
+		$$ = $1
+	}
+|	UpdateStmt
+	{
+		$$ = $1
+	}
+|	DeleteFromStmt
+	{
+		$$ = $1
+	}
+|	SetStmt
+	{
+		$$ = $1
+	}
+|	CommitStmt
+	{
+		$$ = $1
+	}
+|	RollbackStmt
+	{
+		$$ = $1
+	}
+|	"NULL"
+	{
+		$$ = &ast.ProcedureNullStmt{}
+	}
+|	ProcedureVarName assignmentEq Expression
+	{
+		$$ = &ast.ProcedureAssignmentStmt{Name: model.NewCIStr($1), Expr: $3}
+	}
+|	ProcedureBlockBody
+	{
+		$$ = $1
+	}
+|	"DECLARE" ProcedureDeclListOpt ProcedureBlockBody
 	{
-		var indexOption *ast.IndexOption
-		if $11 != nil {
-			indexOption = $11.(*ast.IndexOption)
-			if indexOption.Tp == model.IndexTypeInvalid {
-				if $5 != nil {
-					indexOption.Tp = $5.(model.IndexType)
-				}
-			}
-		} else {
-			indexOption = &ast.IndexOption{}
-			if $5 != nil {
-				indexOption.Tp = $5.(model.IndexType)
-			}
-		}
-		$$ = &ast.CreateIndexStmt{
-			Unique:        $2.(bool),
-			IndexName:     $4,
-			Table:         $7.(*ast.TableName),
-			IndexColNames: $9.([]*ast.IndexColName),
-			IndexOption:   indexOption,
-		}
+		block := $3.(*ast.ProcedureBlock)
+		block.Decls = $2.([]*ast.ProcedureDecl)
+		$$ = block
 	}
 
 
-CreateIndexStmtUnique:
-	/* EMPTY */ { $$ = false }
-|	"UNIQUE"    { $$ = true }
+ProcedureExceptionOpt:
+	/* EMPTY */
+	{
+		$$ = []*ast.ProcedureExceptionHandler{}
+	}
+|	"EXCEPTION" ProcedureExceptionHandlerList
+	{
+		$$ = $2
+	}
 
 
-IndexColName:
-	ColumnName OptFieldLen Order
+ProcedureExceptionHandlerList:
+	ProcedureExceptionHandler
 	{
-		//Order is parsed but just ignored as MySQL did
-		$$ = &ast.IndexColName{Column: $1.(*ast.ColumnName), Length: $2.(int)}
+		$$ = []*ast.ProcedureExceptionHandler{$1.(*ast.ProcedureExceptionHandler)}
+	}
+|	ProcedureExceptionHandlerList ProcedureExceptionHandler
+	{
+		$$ = append($1.([]*ast.ProcedureExceptionHandler), $2.(*ast.ProcedureExceptionHandler))
 	}
 
 
-IndexColNameList:
-	IndexColName                      { $$ = []*ast.IndexColName{$1.(*ast.IndexColName)} }
-|	IndexColNameList ',' IndexColName { $$ = append($1.([]*ast.IndexColName), $3.(*ast.IndexColName)) }
+ProcedureExceptionHandler:
+	"WHEN" ExceptionNameList "THEN" ProcedureStmtList
+	{
+		x := &ast.ProcedureExceptionHandler{Names: $2.([]model.CIStr), Stmts: $4.([]ast.StmtNode)}
+		if len(x.Names) == 1 && x.Names[0].L == "others" {
+			x.Names = nil
+		}
+		$$ = x
+	}
 
 
-/**************************************AlterDatabaseStmt***************************************
- * See https://dev.mysql.com/doc/refman/5.7/en/alter-database.html
- * 'ALTER DATABASE ... UPGRADE DATA DIRECTORY NAME' is not supported yet.
- *
- *  ALTER {DATABASE | SCHEMA} [db_name]
- *   alter_specification ...
- *
- *  alter_specification:
- *   [DEFAULT] CHARACTER SET [=] charset_name
- * | [DEFAULT] COLLATE [=] collation_name
- *******************************************************************************************/
-AlterDatabaseStmt:
-	"ALTER" DatabaseSym DBName DatabaseOptionList
+ExceptionNameList:
+	Identifier
 	{
-		$$ = &ast.AlterDatabaseStmt{
-			Name:                 $3.(string),
-			AlterDefaultDatabase: false,
-			Options:              $4.([]*ast.DatabaseOption),
-		}
+		$$ = []model.CIStr{model.NewCIStr($1)}
 	}
-|	"ALTER" DatabaseSym DatabaseOptionList
+|	ExceptionNameList "OR" Identifier
 	{
-		$$ = &ast.AlterDatabaseStmt{
-			Name:                 "",
-			AlterDefaultDatabase: true,
-			Options:              $3.([]*ast.DatabaseOption),
-		}
+		$$ = append($1.([]model.CIStr), model.NewCIStr($3))
 	}
 
 
 /*******************************************************************
  *
  *  Create Database Statement
- *  CREATE {DATABASE | SCHEMA} [IF NOT EXISTS] db_name
+ *  CREATE [OR REPLACE] {DATABASE | SCHEMA} [IF NOT EXISTS] db_name
  *      [create_specification] ...
  *
  *  create_specification:
  *      [DEFAULT] CHARACTER SET [=] charset_name
  *    | [DEFAULT] COLLATE [=] collation_name
+ *    | COMMENT [=] 'comment'
  *******************************************************************/
 CreateDatabaseStmt:
-	"CREATE" DatabaseSym IfNotExists DBName DatabaseOptionListOpt
+	"CREATE" OrReplace DatabaseSym IfNotExists DBName DatabaseOptionListOpt
 	{
+		if !parser.checkOrReplace($2.(bool), $4.(bool), FeatureCreateOrReplaceDatabase, parser.startOffset(&yyS[yypt-4])) {
+			return 1
+		}
 		$$ = &ast.CreateDatabaseStmt{
-			IfNotExists:	$3.(bool),
-			Name:		$4.(string),
-			Options:	$5.([]*ast.DatabaseOption),
+			OrReplace:	$2.(bool),
+			IfNotExists:	$4.(bool),
+			Name:		$5.(string),
+			Options:	$6.([]*ast.DatabaseOption),
 		}
 	}
 
@@ -1928,6 +2908,10 @@ DatabaseOption:
 	{
 		$$ = &ast.DatabaseOption{Tp: ast.DatabaseOptionCollate, Value: $4.(string)}
 	}
+|	"COMMENT" EqOpt stringLit
+	{
+		$$ = &ast.DatabaseOption{Tp: ast.DatabaseOptionComment, Value: $3}
+	}
 
 
 DatabaseOptionListOpt:
@@ -1957,25 +2941,33 @@ DatabaseOptionList:
  *******************************************************************/
 
 CreateTableStmt:
-	"CREATE" "TABLE" IfNotExists TableName TableElementListOpt CreateTableOptionListOpt PartitionOpt DuplicateOpt AsOpt CreateTableSelectOpt
+	"CREATE" OrReplace "TABLE" IfNotExists TableName TableElementListOpt CreateTableOptionListOpt PartitionOpt DuplicateOpt AsOpt CreateTableSelectOpt
 	{
-		stmt := $5.(*ast.CreateTableStmt)
-		stmt.Table = $4.(*ast.TableName)
-		stmt.IfNotExists = $3.(bool)
-		stmt.Options = $6.([]*ast.TableOption)
-		if $7 != nil {
-			stmt.Partition = $7.(*ast.PartitionOptions)
+		if !parser.checkOrReplace($2.(bool), $4.(bool), FeatureCreateOrReplaceTable, parser.startOffset(&yyS[yypt-9])) {
+			return 1
 		}
-		stmt.OnDuplicate = $8.(ast.OnDuplicateKeyHandlingType)
-		stmt.Select = $10.(*ast.CreateTableStmt).Select
+		stmt := $6.(*ast.CreateTableStmt)
+		stmt.OrReplace = $2.(bool)
+		stmt.Table = $5.(*ast.TableName)
+		stmt.IfNotExists = $4.(bool)
+		stmt.Options = $7.([]*ast.TableOption)
+		if $8 != nil {
+			stmt.Partition = $8.(*ast.PartitionOptions)
+		}
+		stmt.OnDuplicate = $9.(ast.OnDuplicateKeyHandlingType)
+		stmt.Select = $11.(*ast.CreateTableStmt).Select
 		$$ = stmt
 	}
-|	"CREATE" "TABLE" IfNotExists TableName LikeTableWithOrWithoutParen
+|	"CREATE" OrReplace "TABLE" IfNotExists TableName LikeTableWithOrWithoutParen
 	{
+		if !parser.checkOrReplace($2.(bool), $4.(bool), FeatureCreateOrReplaceTable, parser.startOffset(&yyS[yypt-4])) {
+			return 1
+		}
 		$$ = &ast.CreateTableStmt{
-			Table:          $4.(*ast.TableName),
-			ReferTable:	$5.(*ast.TableName),
-			IfNotExists:    $3.(bool),
+			OrReplace:      $2.(bool),
+			Table:          $5.(*ast.TableName),
+			ReferTable:	$6.(*ast.TableName),
+			IfNotExists:    $4.(bool),
 		}
 	}
 
@@ -2330,9 +3322,13 @@ DropDatabaseStmt:
 
 
 DropIndexStmt:
-	"DROP" "INDEX" IfExists Identifier "ON" TableName
+	"DROP" "INDEX" IfExists Identifier "ON" TableName LockAndAlgorithmOpt
 	{
-		$$ = &ast.DropIndexStmt{IfExists: $3.(bool), IndexName: $4, Table: $6.(*ast.TableName)}
+		x := &ast.DropIndexStmt{IfExists: $3.(bool), IndexName: $4, Table: $6.(*ast.TableName)}
+		if $7 != nil {
+			x.LockAlg = $7.(*ast.LockAndAlgorithm)
+		}
+		$$ = x
 	}
 
 
@@ -2372,6 +3368,7 @@ DropUserStmt:
 
 DropRoleStmt:
 	"DROP" "ROLE" RolenameList {
+		parser.checkFeature(FeatureRoles, parser.startOffset(&yyS[yypt-1]))
 		tmp := make([]*auth.UserIdentity, 0, 10)
 		roleList := $3.([]*auth.RoleIdentity)
 		for _, r := range roleList {
@@ -2380,6 +3377,7 @@ DropRoleStmt:
 		$$ = &ast.DropUserStmt{IsDropRole: true, IfExists: false, UserList: tmp}
 	}
 |	"DROP" "ROLE" "IF" "EXISTS" RolenameList {
+		parser.checkFeature(FeatureRoles, parser.startOffset(&yyS[yypt-3]))
 		tmp := make([]*auth.UserIdentity, 0, 10)
 		roleList := $5.([]*auth.RoleIdentity)
 		for _, r := range roleList {
@@ -2389,6 +3387,13 @@ DropRoleStmt:
 	}
 
 
+DropServerStmt:
+	"DROP" "SERVER" IfExists StringName
+	{
+		$$ = &ast.DropServerStmt{IfExists: $3.(bool), Name: $4.(string)}
+	}
+
+
 RestrictOrCascadeOpt:
 	/* EMPTY */ {}
 |	"RESTRICT"
@@ -2447,18 +3452,18 @@ ExplainStmt:
 			ConnectionID: getUint64FromNUM($4),
 		}
 	}
-|	ExplainSym "FORMAT" "=" stringLit "FOR" "CONNECTION" NUM
+|	ExplainSym ExplainFormat "FOR" "CONNECTION" NUM
 	{
 		$$ = &ast.ExplainForStmt{
-			Format:       $4,
-			ConnectionID: getUint64FromNUM($7),
+			Format:       $2,
+			ConnectionID: getUint64FromNUM($5),
 		}
 	}
-|	ExplainSym "FORMAT" "=" stringLit ExplainableStmt
+|	ExplainSym ExplainFormat ExplainableStmt
 	{
 		$$ = &ast.ExplainStmt{
-			Stmt:	$5,
-			Format: $4,
+			Stmt:	$3,
+			Format: $2,
 		}
 	}
 |   ExplainSym "ANALYZE" ExplainableStmt
@@ -2469,6 +3474,64 @@ ExplainStmt:
             Analyze: true,
         }
     }
+|	ExplainSym "EXTENDED" ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:     $3,
+			Format:   "row",
+			Extended: true,
+		}
+	}
+|	ExplainSym "PARTITIONS" ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:       $3,
+			Format:     "row",
+			Partitions: true,
+		}
+	}
+
+
+ExplainFormat:
+	"FORMAT" "=" ExplainFormatName
+	{
+		if !isExplainFormat($3) {
+			yylex.AppendError(ErrUnknownExplainFormat.GenWithStackByArgs($3))
+			return 1
+		}
+		$$ = $3
+	}
+
+
+ExplainFormatName:
+	stringLit
+|	Identifier
+|	"ROW"
+
+
+/*******************************************************************
+ *
+ *  MariaDB ANALYZE Statement
+ *
+ *  Example:
+ *      ANALYZE FORMAT=JSON SELECT * FROM t WHERE a > 1
+ *  See https://mariadb.com/kb/en/analyze-statement/
+ *******************************************************************/
+AnalyzeStmt:
+	"ANALYZE" ExplainableStmt
+	{
+		$$ = &ast.AnalyzeStmt{
+			Stmt:   $2,
+			Format: "row",
+		}
+	}
+|	"ANALYZE" ExplainFormat ExplainableStmt
+	{
+		$$ = &ast.AnalyzeStmt{
+			Stmt:   $3,
+			Format: $2,
+		}
+	}
 
 
 LengthNum:
@@ -2770,6 +3833,7 @@ FieldList:
 
 GroupByClause:
 	"GROUP" "BY" ByList { $$ = &ast.GroupByClause{Items: $3.([]*ast.ByItem)} }
+|	"GROUP" "BY" ByList withRollup { $$ = &ast.GroupByClause{Items: $3.([]*ast.ByItem), Rollup: true} }
 
 
 HavingClause:
@@ -2813,6 +3877,8 @@ IndexOptionList:
 				opt1.Tp = opt2.Tp
 			} else if opt2.KeyBlockSize > 0 {
 			    opt1.KeyBlockSize = opt2.KeyBlockSize
+			} else if len(opt2.EngineAttributes) > 0 {
+				opt1.EngineAttributes = append(opt1.EngineAttributes, opt2.EngineAttributes...)
 			}
 			$$ = opt1
 		}
@@ -2838,6 +3904,12 @@ IndexOption:
 			Comment: $2,
 		}
 	}
+|	EngineAttribute
+	{
+		$$ = &ast.IndexOption {
+			EngineAttributes: []*model.EngineAttribute{$1.(*model.EngineAttribute)},
+		}
+	}
 
 
 IndexType:
@@ -2852,25 +3924,39 @@ IndexTypeOpt:
 
 /**********************************Identifier********************************************/
 Identifier:
-identifier | UnReservedKeyword | NotKeywordToken
+identifier | UnReservedKeyword | BlockKeyword | NotKeywordToken
+
+
+/* BEGIN and END are unreserved keywords, but they can't name a stored program variable, as they delimit its blocks. */
+BlockKeyword:
+	"BEGIN" | "END"
+
+
+ProcedureVarName:
+	identifier | UnReservedKeyword | NotKeywordToken
 
 
 UnReservedKeyword:
-  "ACTION" | "ASCII" | "AUTO_INCREMENT" | "AFTER" | "ALWAYS" | "AVG" | "BEGIN" | "BIT" | "BOOL" | "BOOLEAN" | "BTREE" | "BYTE" | "CLEANUP" | "CHARSET" %prec charsetKwd
+  "ACTION" | "ASCII" | "AUTO_INCREMENT" | "AFTER" | "ALWAYS" | "AVG" | "BIT" | "BOOL" | "BOOLEAN" | "BTREE" | "BYTE" | "CLEANUP" | "CHARSET" %prec charsetKwd
 | "COLUMNS" | "COMMIT" | "COMPACT" | "COMPRESSED" | "CONSISTENT" | "CURRENT" | "DATA" | "DATE" %prec lowerThanStringLitToken| "DATETIME" | "DAY" | "DEALLOCATE" | "DO" | "DUPLICATE"
-| "DYNAMIC"| "END" | "ENGINE" | "ENGINES" | "ENUM" | "ERRORS" | "ESCAPE" | "EXECUTE" | "FIELDS" | "FIRST" | "FIXED" | "FLUSH" | "FOLLOWING" | "FORMAT" | "FULL" |"GLOBAL"
+| "DYNAMIC" | "ENGINE" | "ENGINES" | "ENUM" | "ERRORS" | "ESCAPE" | "EXECUTE" | "FIELDS" | "FIRST" | "FIXED" | "FLUSH" | "FOLLOWING" | "FORMAT" | "FULL" |"GLOBAL"
 | "HASH" | "HOUR" | "LESS" | "LOCAL" | "LAST" | "NAMES" | "OFFSET" | "PASSWORD" %prec lowerThanEq | "PREPARE" | "QUICK" | "REDUNDANT"
 | "ROLE" |"ROLLBACK" | "SESSION" | "SIGNED" | "SNAPSHOT" | "START" | "STATUS" | "OPEN" | "SUBPARTITIONS" | "SUBPARTITION" | "TABLES" | "TABLESPACE" | "TEXT" | "THAN" | "TIME" %prec lowerThanStringLitToken
 | "TIMESTAMP" %prec lowerThanStringLitToken | "TRANSACTION" | "TRUNCATE" | "UNBOUNDED" | "UNKNOWN" | "VALUE" | "WARNINGS" | "YEAR" | "MODE"  | "WEEK"  | "ANY" | "SOME" | "USER" | "IDENTIFIED"
 | "COLLATION" | "COMMENT" | "AVG_ROW_LENGTH" | "CONNECTION" | "CHECKSUM" | "COMPRESSION" | "KEY_BLOCK_SIZE" | "MASTER" | "MAX_ROWS"
 | "MIN_ROWS" | "NATIONAL" | "ROW_FORMAT" | "QUARTER" | "GRANTS" | "TRIGGERS" | "DELAY_KEY_WRITE" | "ISOLATION" | "JSON"
 | "REPEATABLE" | "RESPECT" | "COMMITTED" | "UNCOMMITTED" | "ONLY" | "SERIALIZABLE" | "LEVEL" | "VARIABLES" | "SQL_CACHE" | "INDEXES" | "PROCESSLIST"
-| "SQL_NO_CACHE" | "DISABLE"  | "ENABLE" | "REVERSE" | "PRIVILEGES" | "NO" | "BINLOG" | "FUNCTION" | "VIEW" | "MODIFY" | "EVENTS" | "PARTITIONS"
+| "SQL_NO_CACHE" | "DISABLE" | "DISCARD" | "ENABLE" | "IMPORT" | "REVERSE" | "PRIVILEGES" | "NO" | "BINLOG" | "FUNCTION" | "VIEW" | "MODIFY" | "EVENTS" | "PARTITIONS"
 | "NONE" | "NULLS" | "SUPER" | "EXCLUSIVE" | "STATS_PERSISTENT" | "ROW_COUNT" | "COALESCE" | "MONTH" | "PROCESS" | "PROFILE" | "PROFILES"
 | "MICROSECOND" | "MINUTE" | "PLUGINS" | "PRECEDING" | "QUERY" | "QUERIES" | "SECOND" | "SEPARATOR" | "SHARE" | "SHARED" | "SLOW" | "MAX_CONNECTIONS_PER_HOUR" | "MAX_QUERIES_PER_HOUR" | "MAX_UPDATES_PER_HOUR"
 | "MAX_USER_CONNECTIONS" | "REPLICATION" | "CLIENT" | "SLAVE" | "RELOAD" | "TEMPORARY" | "ROUTINE" | "EVENT" | "ALGORITHM" | "DEFINER" | "INVOKER" | "MERGE" | "TEMPTABLE" | "UNDEFINED" | "SECURITY" | "CASCADED"
 | "RECOVER" | "CIPHER" | "SUBJECT" | "ISSUER" | "X509" | "NEVER" | "EXPIRE" | "ACCOUNT" | "CPU" | "MEMORY" | "BLOCK" | "IO" | "CONTEXT" | "SWITCHES" | "PAGE" | "FAULTS" | "IPC" | "SWAPS" | "SOURCE"
-| "SQL_BUFFER_RESULT"
+| "SQL_BUFFER_RESULT" | "EXTENDED" | "INSTALL" | "UNINSTALL" | "PLUGIN" | "SONAME"
+| "AUTHORS" | "BODY" | "CLIENT_STATISTICS" | "CODE" | "CONTRIBUTORS" | "INDEX_STATISTICS" | "LOCALES" | "MUTEX" | "PACKAGE"
+| "QUERY_RESPONSE_TIME" | "SEQUENCE" | "STATEMENT" | "HARD" | "ID" | "SOFT"
+| "BACKUP" | "BLOCK_COMMIT" | "BLOCK_DDL" | "CACHE" | "ERROR" | "EXPORT" | "GENERAL" | "HOSTS" | "RELAY" | "STAGE" | "USER_RESOURCES" | "XML"
+| "ENCRYPTED" | "ENCRYPTION_KEY_ID" | "PAGE_COMPRESSED" | "PAGE_COMPRESSION_LEVEL" | "YES" | "INET6" | "UUID" | "VECTOR" | "PERSISTENT" | "ROLLUP" | "STORAGE" | "TABLE_STATISTICS" | "USER_STATISTICS" | "WSREP_MEMBERSHIP" | "WSREP_STATUS" | "VIA" | "ADMIN" | "MAX_STATEMENT_TIME" | "PROXY" | "SERVER" | "WRAPPER" | "OPTIONS" | "HOST" | "PORT" | "SOCKET" | "OWNER" | "UPGRADE" | "DIRECTORY" | "NAME" | "BINDING" | "BINDINGS" | "ROWS"
+| "CLOB" | "NUMBER" | "RAW" | "VARCHAR2"
 
 
 NotKeywordToken:
@@ -2937,7 +4023,7 @@ IntoOpt:
 
 
 InsertValues:
-	'(' ColumnNameListOpt ')' ValueSym ValuesList
+	'(' ColumnNameListOpt ')' "VALUE" ValuesList
 	{
 		$$ = &ast.InsertStmt{
 			Columns:   $2.([]*ast.ColumnName),
@@ -2946,7 +4032,16 @@ InsertValues:
 	}
 |	'(' ColumnNameListOpt ')' SelectStmt
 	{
-		$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Select: $4.(*ast.SelectStmt)}
+		st := $4.(*ast.SelectStmt)
+		if st.Kind != ast.SelectStmtKindValues {
+			$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Select: st}
+		} else if st.OrderBy != nil || st.Limit != nil {
+			// INSERT ... VALUES takes no ORDER BY or LIMIT.
+			yylex.AppendError(yylex.Errorf(""))
+			return 1
+		} else {
+			$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Lists: st.Lists}
+		}
 	}
 |	'(' ColumnNameListOpt ')' '(' SelectStmt ')'
 	{
@@ -2956,7 +4051,7 @@ InsertValues:
 	{
 		$$ = &ast.InsertStmt{Columns: $2.([]*ast.ColumnName), Select: $4.(*ast.UnionStmt)}
 	}
-|	ValueSym ValuesList %prec insertValues
+|	"VALUE" ValuesList %prec insertValues
 	{
 		$$ = &ast.InsertStmt{Lists:  $2.([][]ast.ExprNode)}
 	}
@@ -2966,7 +4061,17 @@ InsertValues:
 	}
 |	SelectStmt
 	{
-		$$ = &ast.InsertStmt{Select: $1.(*ast.SelectStmt)}
+		st := $1.(*ast.SelectStmt)
+		if st.Kind != ast.SelectStmtKindValues {
+			$$ = &ast.InsertStmt{Select: st}
+		} else if st.OrderBy != nil || st.Limit != nil {
+			// INSERT ... VALUES takes no ORDER BY or LIMIT.
+			yylex.AppendError(yylex.Errorf(""))
+			return 1
+		} else {
+			// INSERT ... VALUES keeps its row list on the InsertStmt.
+			$$ = &ast.InsertStmt{Lists: st.Lists}
+		}
 	}
 |	UnionStmt
 	{
@@ -2978,10 +4083,6 @@ InsertValues:
 	}
 
 
-ValueSym:
-"VALUE" | "VALUES"
-
-
 ValuesList:
 	RowValue
 	{
@@ -3277,9 +4378,14 @@ BitExpr:
 SimpleIdent:
 	Identifier
 	{
-		$$ = &ast.ColumnNameExpr{Name: &ast.ColumnName{
-			Name: model.NewCIStr($1),
-		}}
+		if parser.lexer.GetSQLMode().HasOracleMode() && strings.ToLower($1) == ast.RowNum {
+			// In Oracle mode ROWNUM is a pseudo column, see https://mariadb.com/kb/en/rownum/
+			$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1)}
+		} else {
+			$$ = &ast.ColumnNameExpr{Name: &ast.ColumnName{
+				Name: model.NewCIStr($1),
+			}}
+		}
 	}
 |	Identifier '.' Identifier
 	{
@@ -3306,7 +4412,7 @@ SimpleIdent:
 
 
 SimpleExpr:
-	SimpleIdent
+	SimpleIdent %prec lowerThanRightParen
 |	FunctionCallKeyword
 |	FunctionCallNonKeyword
 |	FunctionCallGeneric
@@ -3514,6 +4620,7 @@ FunctionNameConflict:
 |	"TIMESTAMP"
 |	"TRUNCATE"
 |	"USER"
+|	"UUID"
 |	"WEEK"
 |	"YEAR"
 
@@ -3868,6 +4975,10 @@ SumExpr:
 		args = append(args, $6.(ast.ExprNode))
 		$$ = &ast.AggregateFuncExpr{F: $1, Args: args, Distinct: $3.(bool)}
 	}
+|	builtinGrouping '(' ExpressionList ')'
+	{
+		$$ = &ast.AggregateFuncExpr{F: $1, Args: $3.([]ast.ExprNode)}
+	}
 |	builtinMax '(' BuggyDefaultFalseDistinctOpt Expression ')'  OptWindowingClause
 	{
 		if $6 != nil {
@@ -3926,7 +5037,12 @@ OptGConcatSeparator:
 FunctionCallGeneric:
 	identifier '(' ExpressionListOpt ')'
 	{
-		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr($1), Args: $3.([]ast.ExprNode)}
+		name := $1
+		if parser.lexer.GetSQLMode().HasOracleMode() && strings.ToLower(name) == ast.Decode {
+			// In Oracle mode DECODE is the Oracle style CASE shorthand, see https://mariadb.com/kb/en/decode_oracle/
+			name = ast.DecodeOracle
+		}
+		$$ = &ast.FuncCallExpr{FnName: model.NewCIStr(name), Args: $3.([]ast.ExprNode)}
 	}
 
 
@@ -4117,6 +5233,11 @@ TableName:
 |	Identifier '.' '*'        { $$ = &ast.TableName{Name:model.NewCIStr($1)} }
 
 
+ObjectName:
+	Identifier                { $$ = &ast.ObjectName{Name: model.NewCIStr($1)} }
+|	Identifier '.' Identifier { $$ = &ast.ObjectName{Schema: model.NewCIStr($1), Name: model.NewCIStr($3)} }
+
+
 TableNameList:
 	TableName
 	{
@@ -4323,6 +5444,21 @@ SelectStmt:
 	{
 		st := $1.(*ast.SelectStmt)
 		st.LockTp = $4.(ast.SelectLockType)
+		if $2 != nil {
+			if st.GroupBy != nil && st.GroupBy.Rollup {
+				yylex.AppendError(ErrWrongUsage.GenWithStackByArgs("CUBE/ROLLUP", "ORDER BY"))
+				return 1
+			}
+			st.OrderBy = $2.(*ast.OrderByClause)
+		}
+		if $3 != nil {
+			st.Limit = $3.(*ast.Limit)
+		}
+		$$ = st
+	}
+|	TableValueConstructor OrderByOptional SelectStmtLimit
+	{
+		st := $1.(*ast.SelectStmt)
 		if $2 != nil {
 			st.OrderBy = $2.(*ast.OrderByClause)
 		}
@@ -4333,13 +5469,28 @@ SelectStmt:
 	}
 
 
+// See https://mariadb.com/kb/en/table-value-constructors/
+TableValueConstructor:
+	"VALUES" ValuesList
+	{
+		$$ = &ast.SelectStmt{
+			Kind:  ast.SelectStmtKindValues,
+			Lists: $2.([][]ast.ExprNode),
+		}
+	}
+
+
 FromDual:
 	"FROM" "DUAL"
 
 
 WindowClauseOptional:
 	/* EMPTY */                   { $$ = nil }
-|	"WINDOW" WindowDefinitionList { $$ = $2.([]ast.WindowSpec) }
+|	"WINDOW" WindowDefinitionList
+	{
+		parser.checkFeature(FeatureWindowFunctions, parser.startOffset(&yyS[yypt-1]))
+		$$ = $2.([]ast.WindowSpec)
+	}
 
 
 WindowDefinitionList:
@@ -4381,8 +5532,9 @@ WindowSpecDetails:
 	}
 
 
+/* A window named rows can't be referenced in a window spec, as ROWS starts the frame clause there. */
 OptExistingWindowName:
-	/* EMPTY */ { $$ = model.CIStr{} }
+	/* EMPTY */ %prec higherThanRows { $$ = model.CIStr{} }
 |	WindowName  { $$ = $1.(model.CIStr) }
 
 
@@ -4502,7 +5654,11 @@ OptWindowingClause:
 
 
 WindowingClause:
-	"OVER" WindowNameOrSpec { $$ = $2.(ast.WindowSpec) }
+	"OVER" WindowNameOrSpec
+	{
+		parser.checkFeature(FeatureWindowFunctions, parser.startOffset(&yyS[yypt-1]))
+		$$ = $2.(ast.WindowSpec)
+	}
 
 
 WindowNameOrSpec:
@@ -4664,6 +5820,17 @@ TableFactor:
 	{
 		$$ = &ast.TableSource{Source: $2.(*ast.UnionStmt), AsName: $4.(model.CIStr)}
 	}
+|	'(' SelectStmt ')' TableAsName '(' ColumnList ')'
+	{
+		st := $2.(*ast.SelectStmt)
+		endOffset := parser.endOffset(&yyS[yypt-4])
+		parser.setLastSelectFieldText(st, endOffset)
+		$$ = &ast.TableSource{Source: st, AsName: $4.(model.CIStr), ColumnNames: $6.([]model.CIStr)}
+	}
+|	'(' UnionStmt ')' TableAsName '(' ColumnList ')'
+	{
+		$$ = &ast.TableSource{Source: $2.(*ast.UnionStmt), AsName: $4.(model.CIStr), ColumnNames: $6.([]model.CIStr)}
+	}
 |	'(' TableRefs ')'
 	{
 		$$ = $2
@@ -5065,6 +6232,23 @@ UnionStmt:
 		}
 		$$ = union
 	}
+|	UnionClauseList "UNION" UnionOpt TableValueConstructor OrderByOptional SelectStmtLimit
+	{
+		st := $4.(*ast.SelectStmt)
+		union := $1.(*ast.UnionStmt)
+		st.IsAfterUnionDistinct = $3.(bool)
+		lastSelect := union.SelectList.Selects[len(union.SelectList.Selects)-1]
+		endOffset := parser.endOffset(&yyS[yypt-4])
+		parser.setLastSelectFieldText(lastSelect, endOffset)
+		union.SelectList.Selects = append(union.SelectList.Selects, st)
+		if $5 != nil {
+			union.OrderBy = $5.(*ast.OrderByClause)
+		}
+		if $6 != nil {
+			union.Limit = $6.(*ast.Limit)
+		}
+		$$ = union
+	}
 |	UnionClauseList "UNION" UnionOpt '(' SelectStmt ')' OrderByOptional SelectStmtLimit
 	{
 		union := $1.(*ast.UnionStmt)
@@ -5157,21 +6341,58 @@ SetStmt:
 	}
 
 
+/*******************************************************************
+ *
+ *  SET STATEMENT Statement
+ *
+ *  Example:
+ *      SET STATEMENT max_statement_time=5, sort_buffer_size=1024 FOR SELECT * FROM t
+ *  See https://mariadb.com/kb/en/set-statement/
+ *******************************************************************/
+SetStatementStmt:
+	"SET" "STATEMENT" SetStatementVarList "FOR" ExplainableStmt
+	{
+		$$ = &ast.SetStatementStmt{Variables: $3.([]*ast.VariableAssignment), Stmt: $5}
+	}
+
+
+SetStatementVarList:
+	SetStatementVar                         { $$ = []*ast.VariableAssignment{$1.(*ast.VariableAssignment)} }
+|	SetStatementVarList ',' SetStatementVar { $$ = append($1.([]*ast.VariableAssignment), $3.(*ast.VariableAssignment)) }
+
+
+SetStatementVar:
+	Identifier eq SetExpr
+	{
+		$$ = &ast.VariableAssignment{Name: $1, Value: $3, IsSystem: true}
+	}
+
+
 SetRoleStmt:
-	"SET" "ROLE" SetRoleOpt { $$ = $3.(*ast.SetRoleStmt) }
+	"SET" "ROLE" SetRoleOpt
+	{
+		parser.checkFeature(FeatureRoles, parser.startOffset(&yyS[yypt-1]))
+		$$ = $3.(*ast.SetRoleStmt)
+	}
 
 
 SetDefaultRoleStmt:
-	"SET" "DEFAULT" "ROLE" SetDefaultRoleOpt "TO" UsernameList {
+	"SET" "DEFAULT" "ROLE" SetDefaultRoleOpt SetDefaultRoleUsersOpt {
+		parser.checkFeature(FeatureRoles, parser.startOffset(&yyS[yypt-2]))
 		tmp := $4.(*ast.SetRoleStmt)
-		$$ = &ast.SetDefaultRoleStmt{
-			SetRoleOpt: tmp.SetRoleOpt,
-			RoleList: tmp.RoleList,
-			UserList: $6.([]*auth.UserIdentity),
-		}
+		stmt := $5.(*ast.SetDefaultRoleStmt)
+		stmt.SetRoleOpt = tmp.SetRoleOpt
+		stmt.RoleList = tmp.RoleList
+		$$ = stmt
 	}
 
 
+SetDefaultRoleUsersOpt:
+	/* EMPTY */          { $$ = &ast.SetDefaultRoleStmt{} }
+|	"TO" UsernameList    { $$ = &ast.SetDefaultRoleStmt{UserList: $2.([]*auth.UserIdentity)} }
+|	"FOR" Username       { $$ = &ast.SetDefaultRoleStmt{UserList: []*auth.UserIdentity{$2.(*auth.UserIdentity)}, ForUser: true} }
+
+
 SetDefaultRoleOpt:
 	"NONE"       { $$ = &ast.SetRoleStmt{SetRoleOpt: ast.SetRoleNone, RoleList: nil} }
 |	"ALL"        { $$ = &ast.SetRoleStmt{SetRoleOpt: ast.SetRoleAll, RoleList: nil} }
@@ -5463,6 +6684,26 @@ ShowStmt:
 	}
 |	"SHOW" "CREATE" "TABLE" TableName { $$ = &ast.ShowStmt{Tp: ast.ShowCreateTable, Table: $4.(*ast.TableName)} }
 |	"SHOW" "CREATE" "USER" Username   { $$ = &ast.ShowStmt{Tp: ast.ShowCreateUser, User: $4.(*auth.UserIdentity)} }
+|	"SHOW" "CREATE" "PROCEDURE" ObjectName     { $$ = &ast.ShowStmt{Tp: ast.ShowCreateProcedure, Object: $4.(*ast.ObjectName)} }
+|	"SHOW" "CREATE" "FUNCTION" ObjectName      { $$ = &ast.ShowStmt{Tp: ast.ShowCreateFunction, Object: $4.(*ast.ObjectName)} }
+|	"SHOW" "CREATE" "TRIGGER" ObjectName       { $$ = &ast.ShowStmt{Tp: ast.ShowCreateTrigger, Object: $4.(*ast.ObjectName)} }
+|	"SHOW" "CREATE" "EVENT" ObjectName         { $$ = &ast.ShowStmt{Tp: ast.ShowCreateEvent, Object: $4.(*ast.ObjectName)} }
+|	"SHOW" "CREATE" "SEQUENCE" TableName
+	{
+		parser.checkFeature(FeatureSequences, parser.startOffset(&yyS[yypt-1]))
+		$$ = &ast.ShowStmt{Tp: ast.ShowCreateSequence, Table: $4.(*ast.TableName)}
+	}
+|	"SHOW" "CREATE" "PACKAGE" ObjectName        { $$ = &ast.ShowStmt{Tp: ast.ShowCreatePackage, Object: $4.(*ast.ObjectName)} }
+|	"SHOW" "CREATE" "PACKAGE" "BODY" ObjectName { $$ = &ast.ShowStmt{Tp: ast.ShowCreatePackageBody, Object: $5.(*ast.ObjectName)} }
+|	"SHOW" "PROCEDURE" "CODE" ObjectName        { $$ = &ast.ShowStmt{Tp: ast.ShowProcedureCode, Object: $4.(*ast.ObjectName)} }
+|	"SHOW" "FUNCTION" "CODE" ObjectName         { $$ = &ast.ShowStmt{Tp: ast.ShowFunctionCode, Object: $4.(*ast.ObjectName)} }
+|	"SHOW" "ENGINE" Identifier "STATUS"        { $$ = &ast.ShowStmt{Tp: ast.ShowEngineStatus, Engine: $3} }
+|	"SHOW" "ENGINE" Identifier "MUTEX"         { $$ = &ast.ShowStmt{Tp: ast.ShowEngineMutex, Engine: $3} }
+|	"SHOW" "ENGINE" "ALL" "STATUS"             { $$ = &ast.ShowStmt{Tp: ast.ShowEngineStatus} }
+|	"SHOW" "ENGINE" "ALL" "MUTEX"              { $$ = &ast.ShowStmt{Tp: ast.ShowEngineMutex} }
+|	"SHOW" BinaryOrMaster "LOGS"               { $$ = &ast.ShowStmt{Tp: ast.ShowBinaryLogs} }
+|	"SHOW" "AUTHORS"                           { $$ = &ast.ShowStmt{Tp: ast.ShowAuthors} }
+|	"SHOW" "CONTRIBUTORS"                      { $$ = &ast.ShowStmt{Tp: ast.ShowContributors} }
 |	"SHOW" "GRANTS"                   { $$ = &ast.ShowStmt{Tp: ast.ShowGrants} }
 |	"SHOW" "GRANTS" "FOR" Username UsingRoles
 	{
@@ -5481,6 +6722,22 @@ ShowStmt:
 		}
 	}
 |	"SHOW" "MASTER" "STATUS"          { $$ = &ast.ShowStmt{Tp: ast.ShowMasterStatus} }
+|	"SHOW" "EXPLAIN" "FOR" NUM
+	{
+		$$ = &ast.ExplainForStmt{
+			Format:       "row",
+			ConnectionID: getUint64FromNUM($4),
+			ShowExplain:  true,
+		}
+	}
+|	"SHOW" "EXPLAIN" ExplainFormat "FOR" NUM
+	{
+		$$ = &ast.ExplainForStmt{
+			Format:       $3,
+			ConnectionID: getUint64FromNUM($5),
+			ShowExplain:  true,
+		}
+	}
 |	"SHOW" OptFull "PROCESSLIST"      { $$ = &ast.ShowStmt{Tp: ast.ShowProcessList, Full: $2.(bool)} }
 |	"SHOW" "PROFILES"                 { $$ = &ast.ShowStmt{Tp: ast.ShowProfiles} }
 |	"SHOW" "PROFILE" ShowProfileTypesOpt ShowProfileArgsOpt SelectStmtLimit
@@ -5555,6 +6812,7 @@ FromOrIn:
 
 ShowTargetFilterable:
 	"ENGINES"            { $$ = &ast.ShowStmt{Tp: ast.ShowEngines} }
+|	"STORAGE" "ENGINES"  { $$ = &ast.ShowStmt{Tp: ast.ShowEngines} }
 |	"DATABASES"          { $$ = &ast.ShowStmt{Tp: ast.ShowDatabases} }
 |	CharsetOrCharacterSet            { $$ = &ast.ShowStmt{Tp: ast.ShowCharset} }
 |	"WARNINGS"           { $$ = &ast.ShowStmt{Tp: ast.ShowWarnings} }
@@ -5631,6 +6889,13 @@ ShowTargetFilterable:
 			GlobalScope: $1.(bool),
 		}
 	}
+|	GlobalScope "BINDINGS"
+	{
+		$$ = &ast.ShowStmt{
+			Tp: ast.ShowBindings,
+			GlobalScope: $1.(bool),
+		}
+	}
 |	"TRIGGERS" ShowDatabaseNameOpt
 	{
 		$$ = &ast.ShowStmt{
@@ -5642,10 +6907,8 @@ ShowTargetFilterable:
 	{
 		// This statement is similar to SHOW PROCEDURE STATUS but for stored functions.
 		// See http://dev.mysql.com/doc/refman/5.7/en/show-function-status.html
-		// We do not support neither stored functions nor stored procedures.
-		// So we reuse show procedure status process logic.
 		$$ = &ast.ShowStmt {
-			Tp: ast.ShowProcedureStatus,
+			Tp: ast.ShowFunctionStatus,
 		}
 	}
 |	"EVENTS" ShowDatabaseNameOpt
@@ -5661,6 +6924,14 @@ ShowTargetFilterable:
 			Tp: 	ast.ShowPlugins,
 		}
 	}
+|	"TABLE_STATISTICS"    { $$ = &ast.ShowStmt{Tp: ast.ShowTableStatistics} }
+|	"INDEX_STATISTICS"    { $$ = &ast.ShowStmt{Tp: ast.ShowIndexStatistics} }
+|	"USER_STATISTICS"     { $$ = &ast.ShowStmt{Tp: ast.ShowUserStatistics} }
+|	"CLIENT_STATISTICS"   { $$ = &ast.ShowStmt{Tp: ast.ShowClientStatistics} }
+|	"QUERY_RESPONSE_TIME" { $$ = &ast.ShowStmt{Tp: ast.ShowQueryResponseTime} }
+|	"LOCALES"             { $$ = &ast.ShowStmt{Tp: ast.ShowLocales} }
+|	"WSREP_STATUS"        { $$ = &ast.ShowStmt{Tp: ast.ShowWsrepStatus} }
+|	"WSREP_MEMBERSHIP"    { $$ = &ast.ShowStmt{Tp: ast.ShowWsrepMembership} }
 
 
 ShowLikeOrWhereOpt:
@@ -5697,29 +6968,77 @@ FlushStmt:
 		tmp.NoWriteToBinLog = $2.(bool)
 		$$ = tmp
 	}
-
-FlushOption:
-	"PRIVILEGES"
+
+FlushOption:
+	"PRIVILEGES"
+	{
+		$$ = &ast.FlushStmt{
+			Tp: ast.FlushPrivileges,
+		}
+	}
+|	"STATUS"
+	{
+		$$ = &ast.FlushStmt{
+			Tp: ast.FlushStatus,
+		}
+	}
+|	TableOrTables TableNameListOpt WithReadLockOpt
+	{
+		$$ = &ast.FlushStmt{
+			Tp: ast.FlushTables,
+			Tables: $2.([]*ast.TableName),
+			ReadLock: $3.(bool),
+		}
+	}
+|	TableOrTables TableNameList "FOR" "EXPORT"
+	{
+		$$ = &ast.FlushStmt{
+			Tp: ast.FlushTables,
+			Tables: $2.([]*ast.TableName),
+			ForExport: true,
+		}
+	}
+|	FlushLogType "LOGS"
+	{
+		$$ = &ast.FlushStmt{
+			Tp: ast.FlushLogs,
+			LogType: $1.(ast.LogType),
+		}
+	}
+|	"HOSTS"
 	{
 		$$ = &ast.FlushStmt{
-			Tp: ast.FlushPrivileges,
+			Tp: ast.FlushHosts,
 		}
 	}
-|	"STATUS"
+|	"QUERY" "CACHE"
 	{
 		$$ = &ast.FlushStmt{
-			Tp: ast.FlushStatus,
+			Tp: ast.FlushQueryCache,
 		}
 	}
-|	TableOrTables TableNameListOpt WithReadLockOpt
+|	"USER_RESOURCES"
 	{
 		$$ = &ast.FlushStmt{
-			Tp: ast.FlushTables,
-			Tables: $2.([]*ast.TableName),
-			ReadLock: $3.(bool),
+			Tp: ast.FlushUserResources,
+		}
+	}
+|	"SSL"
+	{
+		$$ = &ast.FlushStmt{
+			Tp: ast.FlushSSL,
 		}
 	}
 
+FlushLogType:
+	/* EMPTY */ { $$ = ast.LogTypeDefault }
+|	"BINARY"    { $$ = ast.LogTypeBinary }
+|	"ENGINE"    { $$ = ast.LogTypeEngine }
+|	"ERROR"     { $$ = ast.LogTypeError }
+|	"GENERAL"   { $$ = ast.LogTypeGeneral }
+|	"RELAY"     { $$ = ast.LogTypeRelay }
+|	"SLOW"      { $$ = ast.LogTypeSlow }
+
 
 NoWriteToBinLogAliasOpt:
 	/* EMPTY */          { $$ = false }
@@ -5748,10 +7067,15 @@ Statement:
 |	AlterTableStmt
 |	AlterDatabaseStmt
 |	AlterUserStmt
+|	AlterServerStmt
+|	AnalyzeStmt
 |	AnalyzeTableStmt
 |	BeginTransactionStmt
 |	BinlogStmt
 |	CommitStmt
+|	CreateBindingStmt
+|	CreatePackageStmt
+|	CreateProcedureStmt
 |	DeallocateStmt
 |	DeleteFromStmt
 |	ExecuteStmt
@@ -5762,28 +7086,36 @@ Statement:
 |	CreateViewStmt
 |	CreateUserStmt
 |	CreateRoleStmt
+|	CreateServerStmt
 |	DoStmt
+|	DropBindingStmt
 |	DropDatabaseStmt
 |	DropIndexStmt
 |	DropTableStmt
 |	DropViewStmt
 |	DropUserStmt
 |	DropRoleStmt
+|	DropServerStmt
 |	FlushStmt
 |	GrantStmt
 |	GrantRoleStmt
+|	GrantProxyStmt
 |	InsertIntoStmt
+|	InstallPluginStmt
 |	KillStmt
 |	LoadDataStmt
 |	PreparedStmt
 |	RollbackStmt
 |	RenameTableStmt
+|	RenameUserStmt
 |	ReplaceIntoStmt
 |	RevokeStmt
 |	RevokeRoleStmt
+|	RevokeProxyStmt
 |	SelectStmt
 |	UnionStmt
 |	SetStmt
+|	SetStatementStmt
 |	SetRoleStmt
 |	SetDefaultRoleStmt
 |	ShowStmt
@@ -5794,10 +7126,12 @@ Statement:
 		$$ = $1.(*ast.SubqueryExpr).Query.(ast.StmtNode)
 	}
 |	TruncateTableStmt
+|	UninstallPluginStmt
 |	UpdateStmt
 |	UseStmt
 |	UnlockTablesStmt
 |	LockTablesStmt
+|	BackupStmt
 |	PurgeStmt
 
 
@@ -5818,6 +7152,7 @@ StatementList:
 			if lexer, ok := yylex.(stmtTexter); ok {
 				s.SetText(lexer.stmtText())
 			}
+			parser.setExecutableComment(s, parser.startOffset(&yyS[yypt]))
 			parser.result = append(parser.result, s)
 		}
 	}
@@ -5828,6 +7163,7 @@ StatementList:
 			if lexer, ok := yylex.(stmtTexter); ok {
 				s.SetText(lexer.stmtText())
 			}
+			parser.setExecutableComment(s, parser.startOffset(&yyS[yypt]))
 			parser.result = append(parser.result, s)
 		}
 	}
@@ -5852,6 +7188,10 @@ TableElement:
 	{
 		$$ = $1.(*ast.Constraint)
 	}
+|	VectorIndexDef
+	{
+		$$ = $1.(*ast.Constraint)
+	}
 |	"CHECK" '(' Expression ')'
 	{
 		/* Nothing to do now */
@@ -5987,6 +7327,31 @@ TableOption:
 		// Parse it but will ignore it.
 		$$ = &ast.TableOption{Tp: ast.TableOptionPackKeys}
 	}
+|	"PAGE_COMPRESSED" EqOpt LengthNum
+	{
+		$$ = &ast.TableOption{Tp: ast.TableOptionPageCompressed, UintValue: $3.(uint64)}
+	}
+|	"PAGE_COMPRESSION_LEVEL" EqOpt LengthNum
+	{
+		$$ = &ast.TableOption{Tp: ast.TableOptionPageCompressionLevel, UintValue: $3.(uint64)}
+	}
+|	"ENCRYPTED" EqOpt YesOrNo
+	{
+		$$ = &ast.TableOption{Tp: ast.TableOptionEncrypted, UintValue: $3.(uint64)}
+	}
+|	"ENCRYPTION_KEY_ID" EqOpt LengthNum
+	{
+		$$ = &ast.TableOption{Tp: ast.TableOptionEncryptionKeyID, UintValue: $3.(uint64)}
+	}
+|	EngineAttribute
+	{
+		$$ = &ast.TableOption{Tp: ast.TableOptionEngineAttribute, EngineAttribute: $1.(*model.EngineAttribute)}
+	}
+
+
+YesOrNo:
+	"YES" { $$ = uint64(1) }
+|	"NO"  { $$ = uint64(0) }
 
 
 StatsPersistentVal:
@@ -6135,6 +7500,26 @@ NumericType:
 		}
 		$$ = x
 	}
+|	"NUMBER" FloatOpt FieldOpts
+	{
+		// NUMBER is DECIMAL when it has a precision and DOUBLE otherwise.
+		fopt := $2.(*ast.FloatOpt)
+		x := types.NewFieldType(mysql.TypeNewDecimal)
+		if fopt.Flen == types.UnspecifiedLength {
+			x.Tp = mysql.TypeDouble
+		}
+		x.Flen = fopt.Flen
+		x.Decimal = fopt.Decimal
+		for _, o := range $3.([]*ast.TypeOpt) {
+			if o.IsUnsigned {
+				x.Flag |= mysql.UnsignedFlag
+			}
+			if o.IsZerofill {
+				x.Flag |= mysql.ZerofillFlag
+			}
+		}
+		$$ = x
+	}
 |	BitValueType OptFieldLen
 	{
 		x := types.NewFieldType($1.(byte))
@@ -6287,6 +7672,16 @@ StringType:
 		}
 		$$ = x
 	}
+|	"VARCHAR2" FieldLen OptBinary
+	{
+		x := types.NewFieldType(mysql.TypeVarchar)
+		x.Flen = $2.(int)
+		x.Charset = $3.(*ast.OptBinary).Charset
+		if $3.(*ast.OptBinary).IsBinary {
+			x.Flag |= mysql.BinaryFlag
+		}
+		$$ = x
+	}
 |	"BINARY" OptFieldLen
 	{
 		x := types.NewFieldType(mysql.TypeString)
@@ -6305,6 +7700,15 @@ StringType:
 		x.Flag |= mysql.BinaryFlag
 		$$ = x
 	}
+|	"RAW" FieldLen
+	{
+		x := types.NewFieldType(mysql.TypeVarchar)
+		x.Flen = $2.(int)
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CharsetBin
+		x.Flag |= mysql.BinaryFlag
+		$$ = x
+	}
 |	BlobType
 	{
 		x := $1.(*types.FieldType)
@@ -6344,6 +7748,34 @@ StringType:
 		x.Collate = charset.CollationBin
 		$$ = x
 	}
+|	"INET6"
+	{
+		parser.checkFeature(FeatureInet6, parser.startOffset(&yyS[yypt]))
+		x := types.NewFieldType(mysql.TypeInet6)
+		x.Decimal = 0
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		$$ = x
+	}
+|	"UUID"
+	{
+		parser.checkFeature(FeatureUUID, parser.startOffset(&yyS[yypt]))
+		x := types.NewFieldType(mysql.TypeUUID)
+		x.Decimal = 0
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		$$ = x
+	}
+|	"VECTOR" FieldLen
+	{
+		parser.checkFeature(FeatureVector, parser.startOffset(&yyS[yypt-1]))
+		x := types.NewFieldType(mysql.TypeVector)
+		x.Flen = $2.(int)
+		x.Decimal = 0
+		x.Charset = charset.CharsetBin
+		x.Collate = charset.CollationBin
+		$$ = x
+	}
 
 
 NationalOpt:
@@ -6409,6 +7841,11 @@ TextType:
 		x := types.NewFieldType(mysql.TypeMediumBlob)
 		$$ = x
 	}
+|	"CLOB"
+	{
+		x := types.NewFieldType(mysql.TypeLongBlob)
+		$$ = x
+	}
 
 
 DateAndTimeType:
@@ -6599,6 +8036,36 @@ UseStmt:
 	"USE" DBName { $$ = &ast.UseStmt{DBName: $2.(string)} }
 
 
+/**************************************InstallPluginStmt***************************************
+ * See https://mariadb.com/kb/en/install-plugin/
+ * See https://mariadb.com/kb/en/install-soname/
+ *******************************************************************************************/
+InstallPluginStmt:
+	"INSTALL" "PLUGIN" IfNotExists Identifier "SONAME" stringLit
+	{
+		$$ = &ast.InstallPluginStmt{IfNotExists: $3.(bool), PluginName: $4, Soname: $6}
+	}
+|	"INSTALL" "SONAME" stringLit
+	{
+		$$ = &ast.InstallPluginStmt{Soname: $3}
+	}
+
+
+/**************************************UninstallPluginStmt*************************************
+ * See https://mariadb.com/kb/en/uninstall-plugin/
+ * See https://mariadb.com/kb/en/uninstall-soname/
+ *******************************************************************************************/
+UninstallPluginStmt:
+	"UNINSTALL" "PLUGIN" IfExists Identifier
+	{
+		$$ = &ast.UninstallPluginStmt{IfExists: $3.(bool), PluginName: $4}
+	}
+|	"UNINSTALL" "SONAME" IfExists stringLit
+	{
+		$$ = &ast.UninstallPluginStmt{IfExists: $3.(bool), Soname: $4}
+	}
+
+
 PurgeStmt:
 	"PURGE" BinaryOrMaster "LOGS" PurgeOption { $$ = &ast.PurgeStmt{} }
 ;
@@ -6634,37 +8101,132 @@ CommaOpt:
  *  https://dev.mysql.com/doc/refman/5.7/en/account-management-sql.html
  ************************************************************************************/
 CreateUserStmt:
-	"CREATE" "USER" IfNotExists UserSpecList RequireClause ConnectionOptions PasswordOrLockOptions
+	"CREATE" OrReplace "USER" IfNotExists UserSpecList RequireClause ConnectionOptions PasswordOrLockOptions
 	{
  		// See https://dev.mysql.com/doc/refman/5.7/en/create-user.html
+		if !parser.checkOrReplace($2.(bool), $4.(bool), FeatureCreateOrReplaceUser, parser.startOffset(&yyS[yypt-6])) {
+			return 1
+		}
 		$$ = &ast.CreateUserStmt{
 			IsCreateRole: false,
-			IfNotExists: $3.(bool),
-			Specs: $4.([]*ast.UserSpec),
-			TslOptions: $5.([]*ast.TslOption),
-			ResourceOptions: $6.([]*ast.ResourceOption),
-			PasswordOrLockOptions: $7.([]*ast.PasswordOrLockOption),		}
+			OrReplace: $2.(bool),
+			IfNotExists: $4.(bool),
+			Specs: $5.([]*ast.UserSpec),
+			TslOptions: $6.([]*ast.TslOption),
+			ResourceOptions: $7.([]*ast.ResourceOption),
+			PasswordOrLockOptions: $8.([]*ast.PasswordOrLockOption),		}
+	}
+
+
+/*******************************************************************
+ *
+ *  Create Server Statement
+ *  See https://mariadb.com/kb/en/create-server/
+ *
+ *******************************************************************/
+CreateServerStmt:
+	"CREATE" OrReplace "SERVER" IfNotExists StringName "FOREIGN" "DATA" "WRAPPER" StringName "OPTIONS" '(' ServerOptionList ')'
+	{
+		if !parser.checkOrReplace($2.(bool), $4.(bool), FeatureCreateOrReplaceServer, parser.startOffset(&yyS[yypt-11])) {
+			return 1
+		}
+		$$ = &ast.CreateServerStmt{
+			OrReplace:	$2.(bool),
+			IfNotExists:	$4.(bool),
+			Name:		$5.(string),
+			Wrapper:	$9.(string),
+			Options:	$12.([]*ast.ServerOption),
+		}
+	}
+
+
+AlterServerStmt:
+	"ALTER" "SERVER" StringName "OPTIONS" '(' ServerOptionList ')'
+	{
+		$$ = &ast.AlterServerStmt{
+			Name:		$3.(string),
+			Options:	$6.([]*ast.ServerOption),
+		}
+	}
+
+
+ServerOptionList:
+	ServerOption
+	{
+		$$ = []*ast.ServerOption{$1.(*ast.ServerOption)}
+	}
+|	ServerOptionList ',' ServerOption
+	{
+		$$ = append($1.([]*ast.ServerOption), $3.(*ast.ServerOption))
+	}
+
+
+ServerOption:
+	"HOST" stringLit
+	{
+		$$ = &ast.ServerOption{Tp: ast.ServerOptionHost, StrValue: $2}
+	}
+|	"DATABASE" stringLit
+	{
+		$$ = &ast.ServerOption{Tp: ast.ServerOptionDatabase, StrValue: $2}
+	}
+|	"USER" stringLit
+	{
+		$$ = &ast.ServerOption{Tp: ast.ServerOptionUser, StrValue: $2}
+	}
+|	"PASSWORD" stringLit
+	{
+		$$ = &ast.ServerOption{Tp: ast.ServerOptionPassword, StrValue: $2}
+	}
+|	"PORT" LengthNum
+	{
+		$$ = &ast.ServerOption{Tp: ast.ServerOptionPort, UintValue: $2.(uint64)}
+	}
+|	"SOCKET" stringLit
+	{
+		$$ = &ast.ServerOption{Tp: ast.ServerOptionSocket, StrValue: $2}
+	}
+|	"OWNER" stringLit
+	{
+		$$ = &ast.ServerOption{Tp: ast.ServerOptionOwner, StrValue: $2}
 	}
 
 
 CreateRoleStmt:
-	"CREATE" "ROLE" IfNotExists RoleSpecList {
+	"CREATE" OrReplace "ROLE" IfNotExists RoleSpecList CreateRoleAdminOpt {
+		parser.checkFeature(FeatureRoles, parser.startOffset(&yyS[yypt-3]))
 		// See https://dev.mysql.com/doc/refman/8.0/en/create-role.html
-		$$ = &ast.CreateUserStmt{
+		if !parser.checkOrReplace($2.(bool), $4.(bool), FeatureCreateOrReplaceRole, parser.startOffset(&yyS[yypt-4])) {
+			return 1
+		}
+		stmt := &ast.CreateUserStmt{
 			IsCreateRole: true,
-			IfNotExists: $3.(bool),
-			Specs: $4.([]*ast.UserSpec),
+			OrReplace: $2.(bool),
+			IfNotExists: $4.(bool),
+			Specs: $5.([]*ast.UserSpec),
+		}
+		if $6 != nil {
+			stmt.AdminUser = $6.(*auth.UserIdentity)
 		}
+		$$ = stmt
 	}
 
 
+CreateRoleAdminOpt:
+	/* EMPTY */             { $$ = nil }
+|	"WITH" "ADMIN" Username { $$ = $3 }
+
+
 /* See http://dev.mysql.com/doc/refman/5.7/en/alter-user.html */
 AlterUserStmt:
-	"ALTER" "USER" IfExists UserSpecList
+	"ALTER" "USER" IfExists UserSpecList RequireClause ConnectionOptions PasswordOrLockOptions
 	{
 		$$ = &ast.AlterUserStmt{
 			IfExists: $3.(bool),
 			Specs: $4.([]*ast.UserSpec),
+			TslOptions: $5.([]*ast.TslOption),
+			ResourceOptions: $6.([]*ast.ResourceOption),
+			PasswordOrLockOptions: $7.([]*ast.PasswordOrLockOption),
 		}
 	}
 | 	"ALTER" "USER" IfExists "USER" '(' ')' "IDENTIFIED" "BY" AuthString
@@ -6746,6 +8308,13 @@ ConnectionOption:
 			Count: $2.(int64),
 		}
 	}
+|	"MAX_STATEMENT_TIME" NumLiteral
+	{
+		$$ = &ast.ResourceOption {
+			Type: ast.MaxStatementTime,
+			Timeout: ast.NewValueExpr($2),
+		}
+	}
 
 
 RequireClause:
@@ -6892,10 +8461,35 @@ ClearPasswordExpireOptions:
 AuthOption:
 	/* EMPTY */                                    { $$ = nil }
 |	"IDENTIFIED" "BY" AuthString                   { $$ = &ast.AuthOption { AuthString: $3.(string), ByAuthString: true } }
-|	"IDENTIFIED" "WITH" StringName                 { $$ = nil }
-|	"IDENTIFIED" "WITH" StringName "BY" AuthString { $$ = &ast.AuthOption { AuthString: $5.(string), ByAuthString: true } }
-|	"IDENTIFIED" "WITH" StringName "AS" HashString { $$ = &ast.AuthOption{ HashString: $5.(string) } }
 |	"IDENTIFIED" "BY" "PASSWORD" HashString        { $$ = &ast.AuthOption{ HashString: $4.(string) } }
+|	"IDENTIFIED" "WITH" StringName "BY" AuthString
+	{
+		$$ = &ast.AuthOption{Plugins: []*ast.AuthPlugin{{Name: $3.(string), ByPassword: true, AuthString: $5.(string)}}}
+	}
+|	"IDENTIFIED" "WITH" AuthPluginList
+	{
+		$$ = &ast.AuthOption{Plugins: $3.([]*ast.AuthPlugin)}
+	}
+|	"IDENTIFIED" "VIA" AuthPluginList
+	{
+		$$ = &ast.AuthOption{Plugins: $3.([]*ast.AuthPlugin)}
+	}
+
+
+AuthPluginList:
+	AuthPlugin                     { $$ = []*ast.AuthPlugin{$1.(*ast.AuthPlugin)} }
+|	AuthPluginList "OR" AuthPlugin { $$ = append($1.([]*ast.AuthPlugin), $3.(*ast.AuthPlugin)) }
+
+
+AuthPlugin:
+	StringName                                      { $$ = &ast.AuthPlugin{Name: $1.(string)} }
+|	StringName AuthUsing AuthString                 { $$ = &ast.AuthPlugin{Name: $1.(string), AuthString: $3.(string)} }
+|	StringName AuthUsing "PASSWORD" '(' AuthString ')' { $$ = &ast.AuthPlugin{Name: $1.(string), ByPassword: true, AuthString: $5.(string)} }
+
+
+AuthUsing:
+	"USING"
+|	"AS"
 
 
 HashString:
@@ -6927,35 +8521,83 @@ RoleSpecList:
  * See https://dev.mysql.com/doc/refman/5.7/en/grant.html
  *************************************************************************************/
 GrantStmt:
-	 "GRANT" PrivElemList "ON" ObjectType PrivLevel "TO" UserSpecList WithGrantOptionOpt
+	 "GRANT" PrivElemList "ON" ObjectType PrivLevel "TO" UserSpecList RequireClause GrantOptions
 	 {
-		$$ = &ast.GrantStmt{
-			Privs: $2.([]*ast.PrivElem),
-			ObjectType: $4.(ast.ObjectTypeType),
-			Level: $5.(*ast.GrantLevel),
-			Users: $7.([]*ast.UserSpec),
-			WithGrant: $8.(bool),
-		}
+		stmt := $9.(*ast.GrantStmt)
+		stmt.Privs = $2.([]*ast.PrivElem)
+		stmt.ObjectType = $4.(ast.ObjectTypeType)
+		stmt.Level = $5.(*ast.GrantLevel)
+		stmt.Users = $7.([]*ast.UserSpec)
+		stmt.TslOptions = $8.([]*ast.TslOption)
+		$$ = stmt
 	 }
 
 
 GrantRoleStmt:
-	 "GRANT" RolenameList "TO" UsernameList
+	 "GRANT" RolenameList "TO" UsernameList WithAdminOptionOpt
 	 {
+		parser.checkFeature(FeatureRoles, parser.startOffset(&yyS[yypt-3]))
 		$$ = &ast.GrantRoleStmt {
 			Roles: $2.([]*auth.RoleIdentity),
 			Users: $4.([]*auth.UserIdentity),
+			WithAdmin: $5.(bool),
+		}
+	}
+
+
+/* See https://mariadb.com/kb/en/grant/#proxy-privileges */
+GrantProxyStmt:
+	"GRANT" "PROXY" "ON" Username "TO" UsernameList WithGrantOptionOpt
+	{
+		$$ = &ast.GrantProxyStmt{
+			LocalUser: $4.(*auth.UserIdentity),
+			ExternalUsers: $6.([]*auth.UserIdentity),
+			WithGrant: $7.(bool),
 		}
 	}
 
 
 WithGrantOptionOpt:
-	/* EMPTY */                           { $$ = false }
-|	"WITH" "GRANT" "OPTION"               { $$ = true }
-|	"WITH" "MAX_QUERIES_PER_HOUR" NUM     { $$ = false }
-|	"WITH" "MAX_UPDATES_PER_HOUR" NUM     { $$ = false }
-|	"WITH" "MAX_CONNECTIONS_PER_HOUR" NUM { $$ = false }
-|	"WITH" "MAX_USER_CONNECTIONS" NUM     { $$ = false }
+	/* EMPTY */             { $$ = false }
+|	"WITH" "GRANT" "OPTION" { $$ = true }
+
+
+WithAdminOptionOpt:
+	/* EMPTY */             { $$ = false }
+|	"WITH" "ADMIN" "OPTION" { $$ = true }
+
+
+GrantOptions:
+	/* EMPTY */            { $$ = &ast.GrantStmt{} }
+|	"WITH" GrantOptionList { $$ = $2 }
+
+
+GrantOptionList:
+	GrantOption
+	{
+		stmt := &ast.GrantStmt{}
+		if opt, ok := $1.(*ast.ResourceOption); ok {
+			stmt.ResourceOptions = append(stmt.ResourceOptions, opt)
+		} else {
+			stmt.WithGrant = true
+		}
+		$$ = stmt
+	}
+|	GrantOptionList GrantOption
+	{
+		stmt := $1.(*ast.GrantStmt)
+		if opt, ok := $2.(*ast.ResourceOption); ok {
+			stmt.ResourceOptions = append(stmt.ResourceOptions, opt)
+		} else {
+			stmt.WithGrant = true
+		}
+		$$ = stmt
+	}
+
+
+GrantOption:
+	"GRANT" "OPTION"   { $$ = nil }
+|	ConnectionOption   { $$ = $1 }
 
 
 PrivElem:
@@ -7072,11 +8714,30 @@ RevokeStmt:
 
 RevokeRoleStmt:
 	"REVOKE" RolenameList "FROM" UsernameList {
+		parser.checkFeature(FeatureRoles, parser.startOffset(&yyS[yypt-2]))
 		$$ = &ast.RevokeRoleStmt {
 			Roles: $2.([]*auth.RoleIdentity),
 			Users: $4.([]*auth.UserIdentity),
 		}
 	}
+|	"REVOKE" "ADMIN" "OPTION" "FOR" RolenameList "FROM" UsernameList {
+		parser.checkFeature(FeatureRoles, parser.startOffset(&yyS[yypt-2]))
+		$$ = &ast.RevokeRoleStmt {
+			AdminOption: true,
+			Roles: $5.([]*auth.RoleIdentity),
+			Users: $7.([]*auth.UserIdentity),
+		}
+	}
+
+
+RevokeProxyStmt:
+	"REVOKE" "PROXY" "ON" Username "FROM" UsernameList
+	{
+		$$ = &ast.RevokeProxyStmt{
+			LocalUser: $4.(*auth.UserIdentity),
+			ExternalUsers: $6.([]*auth.UserIdentity),
+		}
+	}
 
 
 /**************************************LoadDataStmt*****************************************
@@ -7090,15 +8751,7 @@ LoadDataStmt:
 			OnDuplicate:        $6.(ast.OnDuplicateKeyHandlingType),
 			Table:              $9.(*ast.TableName),
 			ColumnsAndUserVars: $14.([]*ast.ColumnNameOrUserVar),
-			IgnoreLines:        $13.(uint64),
-		}
-		if $3 != nil {
-			x.IsLocal = true
-			// See https://dev.mysql.com/doc/refman/5.7/en/load-data.html#load-data-duplicate-key-handling
-			// If you do not specify IGNORE or REPLACE modifier , then we set default behavior to IGNORE when LOCAL modifier is specified
-			if x.OnDuplicate == ast.OnDuplicateKeyHandlingError {
-				x.OnDuplicate = ast.OnDuplicateKeyHandlingIgnore
-			}
+			Charset:            $10.(string),
 		}
 		if $11 != nil {
 			x.FieldsInfo = $11.(*ast.FieldsClause)
@@ -7106,28 +8759,39 @@ LoadDataStmt:
 		if $12 != nil {
 			x.LinesInfo = $12.(*ast.LinesClause)
 		}
-		if $15 != nil {
-			x.ColumnAssignments = $15.([]*ast.Assignment)
-		}
-		columns := []*ast.ColumnName{}
-		for _, v := range x.ColumnsAndUserVars {
-			if v.ColumnName != nil {
-				columns = append(columns, v.ColumnName)
-			}
+		setLoadDataStmt(x, $3 != nil, $13, $15)
+		$$ = x
+	}
+|	"LOAD" "XML" LocalOpt "INFILE" stringLit DuplicateOpt "INTO" "TABLE" TableName CharsetOpt XMLRowsIdentifiedOpt IgnoreLines ColumnNameOrUserVarListOptWithBrackets LoadDataSetSpecOpt
+	{
+		x := &ast.LoadDataStmt{
+			IsXML:              true,
+			Path:               $5,
+			OnDuplicate:        $6.(ast.OnDuplicateKeyHandlingType),
+			Table:              $9.(*ast.TableName),
+			Charset:            $10.(string),
+			RowsIdentifiedBy:   $11,
+			ColumnsAndUserVars: $13.([]*ast.ColumnNameOrUserVar),
 		}
-		x.Columns = columns
+		setLoadDataStmt(x, $3 != nil, $12, $14)
 		$$ = x
 	}
 
 
 IgnoreLines:
-	/* EMPTY */          { $$ = uint64(0) }
-|	"IGNORE" NUM "LINES" { $$ = getUint64FromNUM($2) }
+	/* EMPTY */          { $$ = nil }
+|	"IGNORE" NUM "LINES" { $$ = &ignoreLines{num: getUint64FromNUM($2)} }
+|	"IGNORE" NUM "ROWS"  { $$ = &ignoreLines{num: getUint64FromNUM($2), rows: true} }
+
+
+XMLRowsIdentifiedOpt:
+	/* EMPTY */                         { $$ = "" }
+|	"ROWS" "IDENTIFIED" "BY" stringLit { $$ = $4 }
 
 
 CharsetOpt:
-	/* EMPTY */ {}
-|	"CHARACTER" "SET" CharsetName
+	/* EMPTY */                       { $$ = "" }
+|	CharsetOrCharacterSet CharsetName { $$ = $2 }
 
 
 LocalOpt:
@@ -7293,6 +8957,38 @@ LockTablesStmt:
 	"LOCK" TablesTerminalSym TableLockList { $$ = &ast.LockTableStmt{} }
 
 
+/*******************************************************************
+ *
+ *  Backup Statement
+ *
+ *  Example:
+ *      BACKUP STAGE BLOCK_DDL
+ *      BACKUP LOCK t
+ *      BACKUP UNLOCK
+ *  See https://mariadb.com/kb/en/backup-stage/
+ *******************************************************************/
+BackupStmt:
+	"BACKUP" "STAGE" BackupStage
+	{
+		$$ = &ast.BackupStmt{Tp: ast.BackupStmtStage, Stage: $3.(ast.BackupStage)}
+	}
+|	"BACKUP" "LOCK" TableName
+	{
+		$$ = &ast.BackupStmt{Tp: ast.BackupStmtLock, Table: $3.(*ast.TableName)}
+	}
+|	"BACKUP" "UNLOCK"
+	{
+		$$ = &ast.BackupStmt{Tp: ast.BackupStmtUnlock}
+	}
+
+BackupStage:
+	"START"        { $$ = ast.BackupStageStart }
+|	"FLUSH"        { $$ = ast.BackupStageFlush }
+|	"BLOCK_DDL"    { $$ = ast.BackupStageBlockDDL }
+|	"BLOCK_COMMIT" { $$ = ast.BackupStageBlockCommit }
+|	"END"          { $$ = ast.BackupStageEnd }
+
+
 TablesTerminalSym:
 	"TABLES"
 |	"TABLE"
@@ -7318,6 +9014,40 @@ TableLockList:
  * See https://dev.mysql.com/doc/refman/5.7/en/kill.html
  *******************************************************************/
 KillStmt:
-	"KILL" NUM              { $$ = &ast.KillStmt{ConnectionID: getUint64FromNUM($2)} }
-|	"KILL" "CONNECTION" NUM { $$ = &ast.KillStmt{ConnectionID: getUint64FromNUM($3)} }
-|	"KILL" "QUERY" NUM      { $$ = &ast.KillStmt{ConnectionID: getUint64FromNUM($3)} }
+	"KILL" KillHardOpt KillTypeOpt NUM
+	{
+		$$ = &ast.KillStmt{
+			Hard:         $2.(bool),
+			Query:        $3.(bool),
+			ConnectionID: getUint64FromNUM($4),
+		}
+	}
+|	"KILL" KillHardOpt "QUERY" "ID" NUM
+	{
+		$$ = &ast.KillStmt{
+			Hard:         $2.(bool),
+			Query:        true,
+			ByQueryID:    true,
+			ConnectionID: getUint64FromNUM($5),
+		}
+	}
+|	"KILL" KillHardOpt KillTypeOpt "USER" Username
+	{
+		$$ = &ast.KillStmt{
+			Hard:  $2.(bool),
+			Query: $3.(bool),
+			User:  $5.(*auth.UserIdentity),
+		}
+	}
+
+
+KillHardOpt:
+	/* EMPTY */ { $$ = false }
+|	"HARD"      { $$ = true }
+|	"SOFT"      { $$ = false }
+
+
+KillTypeOpt:
+	/* EMPTY */  { $$ = false }
+|	"CONNECTION" { $$ = false }
+|	"QUERY"      { $$ = true }
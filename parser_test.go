@@ -15,6 +15,7 @@ package parser
 
 import (
 	"fmt"
+	"regexp"
 	"runtime"
 	"strings"
 	"testing"
@@ -39,7 +40,8 @@ func TestT(t *testing.T) {
 var _ = Suite(&testParserSuite{})
 
 type testParserSuite struct {
-	enableWindowFunc bool
+	enableWindowFunc  bool
+	enableTiDBAnalyze bool
 }
 
 func (s *testParserSuite) TestSimple(c *C) {
@@ -307,6 +309,7 @@ type testErrMsgCase struct {
 func (s *testParserSuite) RunTest(c *C, table []testCase) {
 	parser := New()
 	parser.EnableWindowFunc(s.enableWindowFunc)
+	parser.EnableTiDBAnalyze(s.enableTiDBAnalyze)
 	for _, t := range table {
 		_, _, err := parser.Parse(t.src, "", "")
 		comment := Commentf("source %v", t.src)
@@ -326,6 +329,7 @@ func (s *testParserSuite) RunRestoreTest(c *C, sourceSQLs, expectSQLs string) {
 	var sb strings.Builder
 	parser := New()
 	parser.EnableWindowFunc(s.enableWindowFunc)
+	parser.EnableTiDBAnalyze(s.enableTiDBAnalyze)
 	comment := Commentf("source %v", sourceSQLs)
 	stmts, _, err := parser.Parse(sourceSQLs, "", "")
 	c.Assert(err, IsNil, comment)
@@ -400,6 +404,10 @@ func (s *testParserSuite) TestDMLStmt(c *C) {
 		{"INSERT INTO foo (a,b,) VALUES (42,314,)", false, ""},
 		{"INSERT INTO foo () VALUES ()", true, "INSERT INTO `foo` () VALUES ()"},
 		{"INSERT INTO foo VALUE ()", true, "INSERT INTO `foo` VALUES ()"},
+		{"INSERT INTO foo VALUES (1) ORDER BY 1", false, ""},
+		{"INSERT INTO foo VALUES (1) LIMIT 1", false, ""},
+		{"INSERT INTO foo (a) VALUES (1), (2) ORDER BY a LIMIT 1", false, ""},
+		{"INSERT INTO foo (VALUES (1) LIMIT 1)", true, "INSERT INTO `foo` (VALUES (1) LIMIT 1)"},
 
 		// for issue 2402
 		{"INSERT INTO tt VALUES (01000001783);", true, "INSERT INTO `tt` VALUES (1000001783)"},
@@ -449,7 +457,7 @@ func (s *testParserSuite) TestDMLStmt(c *C) {
 		// load data
 		{"load data local infile '/tmp/tmp.csv' into table t1 fields terminated by ',' optionally enclosed by '\"' ignore 1 lines", true, "LOAD DATA LOCAL INFILE '/tmp/tmp.csv' IGNORE INTO TABLE `t1` FIELDS TERMINATED BY ',' ENCLOSED BY '\"' IGNORE 1 LINES"},
 		{"load data infile '/tmp/t.csv' into table t", true, "LOAD DATA INFILE '/tmp/t.csv' INTO TABLE `t`"},
-		{"load data infile '/tmp/t.csv' into table t character set utf8", true, "LOAD DATA INFILE '/tmp/t.csv' INTO TABLE `t`"},
+		{"load data infile '/tmp/t.csv' into table t character set utf8", true, "LOAD DATA INFILE '/tmp/t.csv' INTO TABLE `t` CHARACTER SET utf8"},
 		{"load data infile '/tmp/t.csv' into table t fields terminated by 'ab'", true, "LOAD DATA INFILE '/tmp/t.csv' INTO TABLE `t` FIELDS TERMINATED BY 'ab'"},
 		{"load data infile '/tmp/t.csv' into table t columns terminated by 'ab'", true, "LOAD DATA INFILE '/tmp/t.csv' INTO TABLE `t` FIELDS TERMINATED BY 'ab'"},
 		{"load data infile '/tmp/t.csv' into table t fields terminated by 'ab' enclosed by 'b'", true, "LOAD DATA INFILE '/tmp/t.csv' INTO TABLE `t` FIELDS TERMINATED BY 'ab' ENCLOSED BY 'b'"},
@@ -463,7 +471,7 @@ func (s *testParserSuite) TestDMLStmt(c *C) {
 		{"load data local infile '/tmp/t.csv' into table t columns terminated by 'ab'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab'"},
 		{"load data local infile '/tmp/t.csv' into table t fields terminated by 'ab' enclosed by 'b'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' ENCLOSED BY 'b'"},
 		{"load data local infile '/tmp/t.csv' into table t fields terminated by 'ab' enclosed by 'b' escaped by '*'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' ENCLOSED BY 'b' ESCAPED BY '*'"},
-		{"load data local infile '/tmp/t.csv' into table t character set utf8 fields terminated by 'ab' enclosed by 'b' escaped by '*'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' ENCLOSED BY 'b' ESCAPED BY '*'"},
+		{"load data local infile '/tmp/t.csv' into table t character set utf8 fields terminated by 'ab' enclosed by 'b' escaped by '*'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` CHARACTER SET utf8 FIELDS TERMINATED BY 'ab' ENCLOSED BY 'b' ESCAPED BY '*'"},
 		{"load data local infile '/tmp/t.csv' into table t lines starting by 'ab'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` LINES STARTING BY 'ab'"},
 		{"load data local infile '/tmp/t.csv' into table t lines starting by 'ab' terminated by 'xy'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` LINES STARTING BY 'ab' TERMINATED BY 'xy'"},
 		{"load data local infile '/tmp/t.csv' into table t fields terminated by 'ab' lines terminated by 'xy'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' LINES TERMINATED BY 'xy'"}, {"load data local infile '/tmp/t.csv' into table t terminated by 'xy' fields terminated by 'ab'", false, ""},
@@ -473,10 +481,10 @@ func (s *testParserSuite) TestDMLStmt(c *C) {
 		{"load data local infile '/tmp/t.csv' into table t columns terminated by 'ab' (a,b)", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' (`a`,`b`)"},
 		{"load data local infile '/tmp/t.csv' into table t fields terminated by 'ab' enclosed by 'b' (a,b)", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' ENCLOSED BY 'b' (`a`,`b`)"},
 		{"load data local infile '/tmp/t.csv' into table t fields terminated by 'ab' enclosed by 'b' escaped by '*' (a,b)", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' ENCLOSED BY 'b' ESCAPED BY '*' (`a`,`b`)"},
-		{"load data local infile '/tmp/t.csv' into table t character set utf8 fields terminated by 'ab' enclosed by 'b' escaped by '*' (a,b)", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' ENCLOSED BY 'b' ESCAPED BY '*' (`a`,`b`)"},
+		{"load data local infile '/tmp/t.csv' into table t character set utf8 fields terminated by 'ab' enclosed by 'b' escaped by '*' (a,b)", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` CHARACTER SET utf8 FIELDS TERMINATED BY 'ab' ENCLOSED BY 'b' ESCAPED BY '*' (`a`,`b`)"},
 		{"load data local infile '/tmp/t.csv' into table t lines starting by 'ab' (a,b)", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` LINES STARTING BY 'ab' (`a`,`b`)"},
 		{"load data local infile '/tmp/t.csv' into table t lines starting by 'ab' terminated by 'xy' (a,b)", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` LINES STARTING BY 'ab' TERMINATED BY 'xy' (`a`,`b`)"},
-		{"load data local infile '/tmp/t.csv' into table t character set utf8 fields terminated by 'ab' lines terminated by 'xy' (a,b)", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' LINES TERMINATED BY 'xy' (`a`,`b`)"},
+		{"load data local infile '/tmp/t.csv' into table t character set utf8 fields terminated by 'ab' lines terminated by 'xy' (a,b)", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` CHARACTER SET utf8 FIELDS TERMINATED BY 'ab' LINES TERMINATED BY 'xy' (`a`,`b`)"},
 		{"load data local infile '/tmp/t.csv' into table t fields terminated by 'ab' lines terminated by 'xy' (a,b)", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' LINES TERMINATED BY 'xy' (`a`,`b`)"}, {"load data local infile '/tmp/t.csv' into table t (a,b) fields terminated by 'ab'", false, ""},
 		{"load data local infile '/tmp/t.csv' into table t (a,b) fields terminated by 'ab'", false, ""},
 		{"load data local infile '/tmp/t.csv' into table t ignore 1 lines", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` IGNORE 1 LINES"},
@@ -489,6 +497,18 @@ func (s *testParserSuite) TestDMLStmt(c *C) {
 		{"load data local infile '/tmp/t.csv' into table t fields terminated by 'ab' enclosed by 'b' enclosed by 'b'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' ENCLOSED BY 'b'"},
 		{"load data local infile '/tmp/t.csv' into table t fields terminated by 'ab' escaped by '' enclosed by 'b'", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' ENCLOSED BY 'b' ESCAPED BY ''"},
 		{"load data local infile '/tmp/t.csv' into table t fields terminated by 'ab' escaped by '' enclosed by 'b' SET b = CAST(CONV(MID(@var1, 3, LENGTH(@var1)-3), 2, 10) AS UNSIGNED)", true, "LOAD DATA LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE `t` FIELDS TERMINATED BY 'ab' ENCLOSED BY 'b' ESCAPED BY '' SET `b`=CAST(CONV(MID(@`var1`, 3, LENGTH(@`var1`)-3), 2, 10) AS UNSIGNED)"},
+		{"load data infile '/tmp/t.csv' into table t charset latin1 ignore 2 lines", true, "LOAD DATA INFILE '/tmp/t.csv' INTO TABLE `t` CHARACTER SET latin1 IGNORE 2 LINES"},
+		{"load data infile '/tmp/t.csv' into table t ignore 2 rows", true, "LOAD DATA INFILE '/tmp/t.csv' INTO TABLE `t` IGNORE 2 ROWS"},
+		{"load data infile '/tmp/t.csv' into table t ignore 2 columns", false, ""},
+		{"load data infile '/tmp/t.csv' into table rows ignore 2 rows (rows)", true, "LOAD DATA INFILE '/tmp/t.csv' INTO TABLE `rows` IGNORE 2 ROWS (`rows`)"},
+
+		// load xml
+		{"load xml infile '/tmp/t.xml' into table t", true, "LOAD XML INFILE '/tmp/t.xml' INTO TABLE `t`"},
+		{"load xml local infile '/tmp/t.xml' into table t", true, "LOAD XML LOCAL INFILE '/tmp/t.xml' IGNORE INTO TABLE `t`"},
+		{"load xml infile '/tmp/t.xml' replace into table t character set utf8 rows identified by '<row>'", true, "LOAD XML INFILE '/tmp/t.xml' REPLACE INTO TABLE `t` CHARACTER SET utf8 ROWS IDENTIFIED BY '<row>'"},
+		{"load xml infile '/tmp/t.xml' into table t rows identified by '<row>' ignore 1 rows (a, @b) set c = @b + 1", true, "LOAD XML INFILE '/tmp/t.xml' INTO TABLE `t` ROWS IDENTIFIED BY '<row>' IGNORE 1 ROWS (`a`,@`b`) SET `c`=@`b`+1"},
+		{"load xml infile '/tmp/t.xml' into table t fields terminated by ','", false, ""},
+		{"load xml infile '/tmp/t.xml' into table t rows identified by row", false, ""},
 
 		{"LOAD DATA INFILE 'file.txt' INTO TABLE t1 (column1, @dummy, column2, @dummy, column3)", true, "LOAD DATA INFILE 'file.txt' INTO TABLE `t1` (`column1`,@`dummy`,`column2`,@`dummy`,`column3`)"},
 		{"LOAD DATA INFILE 'file.txt' INTO TABLE t1 (column1, @var1) SET column2 = @var1/100", true, "LOAD DATA INFILE 'file.txt' INTO TABLE `t1` (`column1`,@`var1`) SET `column2`=@`var1`/100"},
@@ -679,10 +699,8 @@ func (s *testParserSuite) TestDBAStmt(c *C) {
 		{`SHOW FIELDS FROM City;`, true, "SHOW COLUMNS IN `City`"},
 		{`SHOW TRIGGERS LIKE 't'`, true, "SHOW TRIGGERS LIKE 't'"},
 		{`SHOW DATABASES LIKE 'test2'`, true, "SHOW DATABASES LIKE 'test2'"},
-		// PROCEDURE and FUNCTION are currently not supported.
-		// And FUNCTION reuse show procedure status process logic.
 		{`SHOW PROCEDURE STATUS WHERE Db='test'`, true, "SHOW PROCEDURE STATUS WHERE `Db`='test'"},
-		{`SHOW FUNCTION STATUS WHERE Db='test'`, true, "SHOW PROCEDURE STATUS WHERE `Db`='test'"},
+		{`SHOW FUNCTION STATUS WHERE Db='test'`, true, "SHOW FUNCTION STATUS WHERE `Db`='test'"},
 		{`SHOW INDEX FROM t;`, true, "SHOW INDEX IN `t`"},
 		{`SHOW KEYS FROM t;`, true, "SHOW INDEX IN `t`"},
 		{`SHOW INDEX IN t;`, true, "SHOW INDEX IN `t`"},
@@ -795,6 +813,19 @@ func (s *testParserSuite) TestDBAStmt(c *C) {
 		{"flush tables tbl1, tbl2, tbl3 with read lock", true, "FLUSH TABLES `tbl1`, `tbl2`, `tbl3` WITH READ LOCK"},
 		{"flush privileges", true, "FLUSH PRIVILEGES"},
 		{"flush status", true, "FLUSH STATUS"},
+		{"flush tables tbl1, tbl2 for export", true, "FLUSH TABLES `tbl1`, `tbl2` FOR EXPORT"},
+		{"flush tables for export", false, ""},
+		{"flush logs", true, "FLUSH LOGS"},
+		{"flush binary logs", true, "FLUSH BINARY LOGS"},
+		{"flush local engine logs", true, "FLUSH NO_WRITE_TO_BINLOG ENGINE LOGS"},
+		{"flush error logs", true, "FLUSH ERROR LOGS"},
+		{"flush general logs", true, "FLUSH GENERAL LOGS"},
+		{"flush relay logs", true, "FLUSH RELAY LOGS"},
+		{"flush slow logs", true, "FLUSH SLOW LOGS"},
+		{"flush hosts", true, "FLUSH HOSTS"},
+		{"flush query cache", true, "FLUSH QUERY CACHE"},
+		{"flush user_resources", true, "FLUSH USER_RESOURCES"},
+		{"flush ssl", true, "FLUSH SSL"},
 	}
 	s.RunTest(c, table)
 }
@@ -1859,8 +1890,8 @@ func (s *testParserSuite) TestDDL(c *C) {
 		{"alter table employees add partition partitions 2;", true, "ALTER TABLE `employees` ADD PARTITION PARTITIONS 2"},
 		{"alter table clients coalesce partition 3;", true, "ALTER TABLE `clients` COALESCE PARTITION 3"},
 		{"alter table clients coalesce partition 4;", true, "ALTER TABLE `clients` COALESCE PARTITION 4"},
-		{"ALTER TABLE t DISABLE KEYS", true, "ALTER TABLE `t`  /* AlterTableType(0) is not supported */ "},
-		{"ALTER TABLE t ENABLE KEYS", true, "ALTER TABLE `t`  /* AlterTableType(0) is not supported */ "},
+		{"ALTER TABLE t DISABLE KEYS", true, "ALTER TABLE `t` DISABLE KEYS"},
+		{"ALTER TABLE t ENABLE KEYS", true, "ALTER TABLE `t` ENABLE KEYS"},
 		{"ALTER TABLE t MODIFY COLUMN a varchar(255)", true, "ALTER TABLE `t` MODIFY COLUMN `a` VARCHAR(255)"},
 		{"ALTER TABLE t CHANGE COLUMN a b varchar(255)", true, "ALTER TABLE `t` CHANGE COLUMN `a` `b` VARCHAR(255)"},
 		{"ALTER TABLE t CHANGE COLUMN a b varchar(255) CHARACTER SET UTF8 BINARY", true, "ALTER TABLE `t` CHANGE COLUMN `a` `b` VARCHAR(255) BINARY CHARACTER SET UTF8"},
@@ -1923,10 +1954,12 @@ func (s *testParserSuite) TestDDL(c *C) {
 		{"ALTER TABLE t RENAME KEY a TO b;", true, "ALTER TABLE `t` RENAME INDEX `a` TO `b`"},
 		{"ALTER TABLE t RENAME INDEX a TO b;", true, "ALTER TABLE `t` RENAME INDEX `a` TO `b`"},
 
-		{"alter table t analyze partition a", true, "ANALYZE TABLE `t` PARTITION `a`"},
-		{"alter table t analyze partition a with 4 buckets", true, "ANALYZE TABLE `t` PARTITION `a` WITH 4 BUCKETS"},
-		{"alter table t analyze partition a index b", true, "ANALYZE TABLE `t` PARTITION `a` INDEX `b`"},
-		{"alter table t analyze partition a index b with 4 buckets", true, "ANALYZE TABLE `t` PARTITION `a` INDEX `b` WITH 4 BUCKETS"},
+		{"alter table t analyze partition a", true, "ALTER TABLE `t` ANALYZE PARTITION `a`"},
+		{"alter table t analyze partition a,b", true, "ALTER TABLE `t` ANALYZE PARTITION `a`,`b`"},
+		{"alter table t analyze partition a with 4 buckets", false, ""},
+		// The INDEX form is only supported when the TiDB form of ANALYZE is enabled, see TestAnalyze.
+		{"alter table t analyze partition a index b", false, ""},
+		{"alter table t analyze partition a index b with 4 buckets", false, ""},
 
 		// For create index statement
 		{"CREATE INDEX idx ON t (a)", true, "CREATE INDEX `idx` ON `t` (`a`)"},
@@ -1934,7 +1967,7 @@ func (s *testParserSuite) TestDDL(c *C) {
 		{"CREATE INDEX idx ON t (a) USING HASH", true, "CREATE INDEX `idx` ON `t` (`a`) USING HASH"},
 		{"CREATE INDEX idx ON t (a) COMMENT 'foo'", true, "CREATE INDEX `idx` ON `t` (`a`) COMMENT 'foo'"},
 		{"CREATE INDEX idx ON t (a) USING HASH COMMENT 'foo'", true, "CREATE INDEX `idx` ON `t` (`a`) USING HASH COMMENT 'foo'"},
-		{"CREATE INDEX idx ON t (a) LOCK=NONE", true, "CREATE INDEX `idx` ON `t` (`a`)"},
+		{"CREATE INDEX idx ON t (a) LOCK=NONE", true, "CREATE INDEX `idx` ON `t` (`a`) LOCK = NONE"},
 		{"CREATE INDEX idx USING BTREE ON t (a) USING HASH COMMENT 'foo'", true, "CREATE INDEX `idx` ON `t` (`a`) USING HASH COMMENT 'foo'"},
 		{"CREATE INDEX idx USING BTREE ON t (a)", true, "CREATE INDEX `idx` ON `t` (`a`) USING BTREE"},
 
@@ -2117,7 +2150,7 @@ func (s *testParserSuite) TestPrivilege(c *C) {
 		{`CREATE USER 'ttt' REQUIRE ISSUER '/C=SE/ST=Stockholm/L=Stockholm/O=MySQL/CN=CA/emailAddress=ca@example.com' AND CIPHER 'EDH-RSA-DES-CBC3-SHA';`, true, "CREATE USER `ttt`@`%` REQUIRE ISSUER '/C=SE/ST=Stockholm/L=Stockholm/O=MySQL/CN=CA/emailAddress=ca@example.com' AND CIPHER 'EDH-RSA-DES-CBC3-SHA'"},
 		{`CREATE USER 'ttt' WITH MAX_QUERIES_PER_HOUR 2;`, true, "CREATE USER `ttt`@`%` WITH MAX_QUERIES_PER_HOUR 2"},
 		{`CREATE USER 'ttt'@'localhost' REQUIRE NONE WITH MAX_QUERIES_PER_HOUR 1 MAX_UPDATES_PER_HOUR 10 PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK;`, true, "CREATE USER `ttt`@`localhost` REQUIRE NONE WITH MAX_QUERIES_PER_HOUR 1 MAX_UPDATES_PER_HOUR 10 PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK"},
-		{`CREATE USER 'u1'@'%' IDENTIFIED WITH 'mysql_native_password' AS '' REQUIRE NONE PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK ;`, true, "CREATE USER `u1`@`%` IDENTIFIED BY PASSWORD '' REQUIRE NONE PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK"}, {`CREATE USER 'test'`, true, "CREATE USER `test`@`%`"},
+		{`CREATE USER 'u1'@'%' IDENTIFIED WITH 'mysql_native_password' AS '' REQUIRE NONE PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK ;`, true, "CREATE USER `u1`@`%` IDENTIFIED VIA `mysql_native_password` REQUIRE NONE PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK"}, {`CREATE USER 'test'`, true, "CREATE USER `test`@`%`"},
 		{`CREATE USER test`, true, "CREATE USER `test`@`%`"},
 		{"CREATE USER `test`", true, "CREATE USER `test`@`%`"},
 		{"CREATE USER test-user", false, ""},
@@ -2140,9 +2173,19 @@ func (s *testParserSuite) TestPrivilege(c *C) {
 		{"CREATE ROLE `test-role`", true, "CREATE ROLE `test-role`@`%`"},
 		{"CREATE ROLE role1", true, "CREATE ROLE `role1`@`%`"},
 		{"CREATE ROLE `role1`@'localhost'", true, "CREATE ROLE `role1`@`localhost`"},
-		{"create user 'bug19354014user'@'%' identified WITH mysql_native_password", true, "CREATE USER `bug19354014user`@`%`"},
-		{"create user 'bug19354014user'@'%' identified WITH mysql_native_password by 'new-password'", true, "CREATE USER `bug19354014user`@`%` IDENTIFIED BY 'new-password'"},
-		{"create user 'bug19354014user'@'%' identified WITH mysql_native_password as 'hashstring'", true, "CREATE USER `bug19354014user`@`%` IDENTIFIED BY PASSWORD 'hashstring'"},
+		{"create user 'bug19354014user'@'%' identified WITH mysql_native_password", true, "CREATE USER `bug19354014user`@`%` IDENTIFIED VIA `mysql_native_password`"},
+		{"create user 'bug19354014user'@'%' identified WITH mysql_native_password by 'new-password'", true, "CREATE USER `bug19354014user`@`%` IDENTIFIED VIA `mysql_native_password` USING PASSWORD('new-password')"},
+		{"create user 'bug19354014user'@'%' identified WITH mysql_native_password as 'hashstring'", true, "CREATE USER `bug19354014user`@`%` IDENTIFIED VIA `mysql_native_password` USING 'hashstring'"},
+		{"create user u identified via unix_socket", true, "CREATE USER `u`@`%` IDENTIFIED VIA `unix_socket`"},
+		{"create user u identified via unix_socket or mysql_native_password using password('x')", true, "CREATE USER `u`@`%` IDENTIFIED VIA `unix_socket` OR `mysql_native_password` USING PASSWORD('x')"},
+		{"create user u identified with ed25519 as 'ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY'", true, "CREATE USER `u`@`%` IDENTIFIED VIA `ed25519` USING 'ZIgUREUg5PVgQ6LskhXmO+eZLS0nC8be6HPjYWR4YJY'"},
+		{"create user u identified via pam using 'mariadb' or 'ed25519' as password('y') require ssl", true, "CREATE USER `u`@`%` IDENTIFIED VIA `pam` USING 'mariadb' OR `ed25519` USING PASSWORD('y') REQUIRE SSL"},
+		{"create user u identified via pam using 'mariadb' with max_queries_per_hour 2", true, "CREATE USER `u`@`%` IDENTIFIED VIA `pam` USING 'mariadb' WITH MAX_QUERIES_PER_HOUR 2"},
+		{"create user u identified via", false, ""},
+		{"create user u identified via pam or", false, ""},
+		{"create user u identified via pam using password 'x'", false, ""},
+		{"alter user u identified via gssapi using 'u@EXAMPLE.COM', v identified by 'x'", true, "ALTER USER `u`@`%` IDENTIFIED VIA `gssapi` USING 'u@EXAMPLE.COM', `v`@`%` IDENTIFIED BY 'x'"},
+		{"grant select on *.* to u identified via unix_socket or ed25519 using password('x')", true, "GRANT SELECT ON *.* TO `u`@`%` IDENTIFIED VIA `unix_socket` OR `ed25519` USING PASSWORD('x')"},
 		{`CREATE USER IF NOT EXISTS 'root'@'localhost' IDENTIFIED BY 'new-password'`, true, "CREATE USER IF NOT EXISTS `root`@`localhost` IDENTIFIED BY 'new-password'"},
 		{`CREATE USER 'root'@'localhost' IDENTIFIED BY 'new-password'`, true, "CREATE USER `root`@`localhost` IDENTIFIED BY 'new-password'"},
 		{`CREATE USER 'root'@'localhost' IDENTIFIED BY PASSWORD 'hashstring'`, true, "CREATE USER `root`@`localhost` IDENTIFIED BY PASSWORD 'hashstring'"},
@@ -2195,6 +2238,252 @@ func (s *testParserSuite) TestPrivilege(c *C) {
 	s.RunTest(c, table)
 }
 
+func (s *testParserSuite) TestAccountManagement(c *C) {
+	table := []testCase{
+		// for rename user
+		{"RENAME USER a TO b", true, "RENAME USER `a`@`%` TO `b`@`%`"},
+		{"rename user 'a'@'h' to 'b'@'h', c to d@localhost", true, "RENAME USER `a`@`h` TO `b`@`h`, `c`@`%` TO `d`@`localhost`"},
+		{"rename user a", false, ""},
+
+		// for proxy privileges
+		{"GRANT PROXY ON ''@'%' TO 'root'@'localhost' WITH GRANT OPTION", true, "GRANT PROXY ON ``@`%` TO `root`@`localhost` WITH GRANT OPTION"},
+		{"grant proxy on u1 to u2, u3", true, "GRANT PROXY ON `u1`@`%` TO `u2`@`%`, `u3`@`%`"},
+		{"revoke proxy on u1 from u2", true, "REVOKE PROXY ON `u1`@`%` FROM `u2`@`%`"},
+		{"grant proxy on u1, u2 to u3", false, ""},
+		{"grant proxy@h to u", true, "GRANT `proxy`@`h` TO `u`@`%`"},
+
+		// for role administration
+		{"CREATE ROLE r WITH ADMIN u@localhost", true, "CREATE ROLE `r`@`%` WITH ADMIN `u`@`localhost`"},
+		{"create role if not exists r with admin current_user", true, "CREATE ROLE IF NOT EXISTS `r`@`%` WITH ADMIN CURRENT_USER"},
+		{"grant r1, r2 to u with admin option", true, "GRANT `r1`@`%`, `r2`@`%` TO `u`@`%` WITH ADMIN OPTION"},
+		{"revoke admin option for r from u", true, "REVOKE ADMIN OPTION FOR `r`@`%` FROM `u`@`%`"},
+		{"revoke admin@h from u", true, "REVOKE `admin`@`h` FROM `u`@`%`"},
+		{"set default role r for u@localhost", true, "SET DEFAULT ROLE `r`@`%` FOR `u`@`localhost`"},
+		{"set default role none", true, "SET DEFAULT ROLE NONE"},
+		{"set default role r", true, "SET DEFAULT ROLE `r`@`%`"},
+		{"set default role all to u, v", true, "SET DEFAULT ROLE ALL TO `u`@`%`, `v`@`%`"},
+		{"set default role r for u, v", false, ""},
+
+		// for account options
+		{"CREATE USER u PASSWORD EXPIRE INTERVAL 30 DAY", true, "CREATE USER `u`@`%` PASSWORD EXPIRE INTERVAL 30 DAY"},
+		{"create user u require ssl with max_statement_time 1.5 max_user_connections 3", true, "CREATE USER `u`@`%` REQUIRE SSL WITH MAX_STATEMENT_TIME 1.5 MAX_USER_CONNECTIONS 3"},
+		{"alter user u with max_queries_per_hour 10 max_statement_time 2", true, "ALTER USER `u`@`%` WITH MAX_QUERIES_PER_HOUR 10 MAX_STATEMENT_TIME 2"},
+		{"alter user if exists u identified by 'x' require issuer 'i' and cipher 'c' with max_updates_per_hour 1 password expire never account lock", true, "ALTER USER IF EXISTS `u`@`%` IDENTIFIED BY 'x' REQUIRE ISSUER 'i' AND CIPHER 'c' WITH MAX_UPDATES_PER_HOUR 1 PASSWORD EXPIRE NEVER ACCOUNT LOCK"},
+		{"alter user u password expire", true, "ALTER USER `u`@`%` PASSWORD EXPIRE"},
+		{"alter user u account unlock", true, "ALTER USER `u`@`%` ACCOUNT UNLOCK"},
+		{"alter user u with max_statement_time 'a'", false, ""},
+		{"grant select on *.* to u identified by password '*AB' require ssl with max_statement_time 1.5", true, "GRANT SELECT ON *.* TO `u`@`%` IDENTIFIED BY PASSWORD '*AB' REQUIRE SSL WITH MAX_STATEMENT_TIME 1.5"},
+		{"grant all on *.* to u with grant option max_queries_per_hour 2", true, "GRANT ALL ON *.* TO `u`@`%` WITH GRANT OPTION MAX_QUERIES_PER_HOUR 2"},
+		{"grant all on *.* to u with max_connections_per_hour 2 grant option", true, "GRANT ALL ON *.* TO `u`@`%` WITH GRANT OPTION MAX_CONNECTIONS_PER_HOUR 2"},
+	}
+	s.RunTest(c, table)
+}
+
+func (s *testParserSuite) TestDatabaseOptions(c *C) {
+	table := []testCase{
+		{"create schema if not exists db comment 'x'", true, "CREATE DATABASE IF NOT EXISTS `db` COMMENT = 'x'"},
+		{"create or replace database db default character set utf8mb4 comment = 'a''b'", true, "CREATE OR REPLACE DATABASE `db` CHARACTER SET = utf8mb4 COMMENT = 'a''b'"},
+		{"create database db comment 1", false, ""},
+		{"alter schema db comment 'y' collate utf8_bin", true, "ALTER DATABASE `db` COMMENT = 'y' COLLATE = utf8_bin"},
+		{"alter database comment 'y'", true, "ALTER DATABASE COMMENT = 'y'"},
+		{"alter database `#mysql50#a-b` upgrade data directory name", true, "ALTER DATABASE `#mysql50#a-b` UPGRADE DATA DIRECTORY NAME"},
+		{"alter schema db upgrade data directory name", true, "ALTER DATABASE `db` UPGRADE DATA DIRECTORY NAME"},
+		{"alter database upgrade data directory name", false, ""},
+		{"drop schema if exists db", true, "DROP DATABASE IF EXISTS `db`"},
+		{"show schemas like 'd%'", true, "SHOW DATABASES LIKE 'd%'"},
+		{"show create schema if not exists db", true, "SHOW CREATE DATABASE IF NOT EXISTS `db`"},
+		{"select name, directory, upgrade from t", true, "SELECT `name`,`directory`,`upgrade` FROM `t`"},
+	}
+	s.RunTest(c, table)
+}
+
+func (s *testParserSuite) TestCreateOrReplace(c *C) {
+	table := []testCase{
+		{"create or replace table t like s", true, "CREATE OR REPLACE TABLE `t` LIKE `s`"},
+		{"create or replace index i on t (a)", true, "CREATE OR REPLACE INDEX `i` ON `t` (`a`)"},
+		{"create or replace unique index i on t (a)", true, "CREATE OR REPLACE UNIQUE INDEX `i` ON `t` (`a`)"},
+		{"create or replace vector index i on t (v)", true, "CREATE OR REPLACE VECTOR INDEX `i` ON `t` (`v`)"},
+		{"create or replace user u identified by 'x'", true, "CREATE OR REPLACE USER `u`@`%` IDENTIFIED BY 'x'"},
+		{"create or replace role r with admin current_user", true, "CREATE OR REPLACE ROLE `r`@`%` WITH ADMIN CURRENT_USER"},
+		{"create or replace schema db", true, "CREATE OR REPLACE DATABASE `db`"},
+		{"create or replace view v as select 1", true, "CREATE OR REPLACE ALGORITHM = UNDEFINED DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS SELECT 1"},
+		{"create or table t (a int)", false, ""},
+	}
+	s.RunTest(c, table)
+
+	wrongUsage := ErrWrongUsage.GenWithStackByArgs("OR REPLACE", "IF NOT EXISTS")
+	errTable := []testErrMsgCase{
+		{"create or replace table if not exists t (a int)", false, wrongUsage},
+		{"create or replace table if not exists t like s", false, wrongUsage},
+		{"create or replace user if not exists u", false, wrongUsage},
+		{"create or replace role if not exists r", false, wrongUsage},
+		{"create or replace database if not exists db", false, wrongUsage},
+		{"create or replace server if not exists s foreign data wrapper mysql options (host 'h')", false, wrongUsage},
+		{"create or replace table t (a int)", false, nil},
+		{"create table if not exists t (a int)", false, nil},
+	}
+	s.RunErrMsgTest(c, errTable)
+}
+
+func (s *testParserSuite) TestBinding(c *C) {
+	table := []testCase{
+		{"create global binding for select * from t where a = 1 using select /*+ max_execution_time(10) */ * from t where a = 2", true, "CREATE GLOBAL BINDING FOR SELECT * FROM `t` WHERE `a`=1 USING SELECT /*+ MAX_EXECUTION_TIME(10)*/ * FROM `t` WHERE `a`=2"},
+		{"create binding for select * from t where a in (1, 2) using select * from t use index(idx) where a in (3, 4, 5)", true, "CREATE SESSION BINDING FOR SELECT * FROM `t` WHERE `a` IN (1,2) USING SELECT * FROM `t` USE INDEX (`idx`) WHERE `a` IN (3,4,5)"},
+		{"create session binding for select 1 using select 2; select 3", true, "CREATE SESSION BINDING FOR SELECT 1 USING SELECT 2; SELECT 3"},
+		{"create global binding for select 1", false, ""},
+		{"drop global binding for select * from t where a = 1", true, "DROP GLOBAL BINDING FOR SELECT * FROM `t` WHERE `a`=1"},
+		{"drop binding for select 1", true, "DROP SESSION BINDING FOR SELECT 1"},
+		{"show global bindings", true, "SHOW GLOBAL BINDINGS"},
+		{"show session bindings where a = 1", true, "SHOW SESSION BINDINGS WHERE `a`=1"},
+		{"select binding, bindings from t", true, "SELECT `binding`,`bindings` FROM `t`"},
+	}
+	s.RunTest(c, table)
+
+	errTable := []testErrMsgCase{
+		{"create binding for select * from t using select * from s", false, ErrBindingMismatch},
+		{"create binding for select a from t using select b from t", false, ErrBindingMismatch},
+		{"create binding for select a from t where a = 1 using select a from t where a > 1", false, ErrBindingMismatch},
+		{"create binding for SELECT a FROM t using select /*+ max_execution_time(10) */ a from t", false, nil},
+	}
+	s.RunErrMsgTest(c, errTable)
+
+	parser := New()
+	stmt, err := parser.ParseOneStmt("create binding for select * from t where a = 1 using select /*+ max_execution_time(10) */ * from t where a = 2;", "", "")
+	c.Assert(err, IsNil)
+	binding := stmt.(*ast.CreateBindingStmt)
+	c.Assert(binding.OriginSel.Text(), Equals, "select * from t where a = 1")
+	c.Assert(binding.HintedSel.Text(), Equals, "select /*+ max_execution_time(10) */ * from t where a = 2")
+}
+
+func (s *testParserSuite) TestExecutableComment(c *C) {
+	table := []struct {
+		src     string
+		restore string
+	}{
+		{"/*!40101 SET NAMES utf8 */", "/*!40101 SET NAMES 'utf8' */"},
+		{"/*M!100301 SET NAMES utf8 */ ", "/*M!100301 SET NAMES 'utf8' */"},
+		{"/*! SET NAMES utf8 */;", "/*! SET NAMES 'utf8' */"},
+		{"  /*!40101 SET NAMES utf8*/", "/*!40101 SET NAMES 'utf8' */"},
+		{"SET /*!40101 NAMES utf8 */", "SET NAMES 'utf8'"},
+		{"/*!40101 SET NAMES */ utf8", "SET NAMES 'utf8'"},
+		{"SET NAMES utf8", "SET NAMES 'utf8'"},
+	}
+	parser := New()
+	for _, t := range table {
+		stmt, err := parser.ParseOneStmt(t.src, "", "")
+		c.Assert(err, IsNil, Commentf("source %v", t.src))
+		var sb strings.Builder
+		err = ast.RestoreStmt(NewRestoreCtx(DefaultRestoreFlags, &sb), stmt)
+		c.Assert(err, IsNil)
+		c.Assert(sb.String(), Equals, t.restore, Commentf("source %v", t.src))
+	}
+
+	stmts, _, err := parser.Parse("/*!40101 SET NAMES utf8 */; select 1; /*M!100500 select 2 */", "", "")
+	c.Assert(err, IsNil)
+	c.Assert(stmts, HasLen, 3)
+	c.Assert(ast.ExecutableCommentOf(stmts[0]), DeepEquals, &ast.ExecutableComment{Version: 40101})
+	c.Assert(ast.ExecutableCommentOf(stmts[1]), IsNil)
+	c.Assert(ast.ExecutableCommentOf(stmts[2]), DeepEquals, &ast.ExecutableComment{MariaDB: true, Version: 100500})
+
+	parser.SetServerVersion(100611)
+	stmts, _, err = parser.Parse("/*!50701 select 1 */; /*!100500 select 2 */", "", "")
+	c.Assert(err, IsNil)
+	c.Assert(stmts, HasLen, 1)
+	c.Assert(ast.ExecutableCommentOf(stmts[0]), DeepEquals, &ast.ExecutableComment{Version: 100500})
+}
+
+func (s *testParserSuite) TestFeatureVersion(c *C) {
+	table := []struct {
+		src     string
+		version int
+		err     string
+	}{
+		{"create table t (a inet6)", 100301, "INET6 data type requires server version 10.5.0, the target is 10.3.1, line 1 column 18"},
+		{"create table t (a inet6)", 100500, ""},
+		{"create table t (a uuid)", 100611, "UUID data type requires server version 10.7.0, the target is 10.6.11, line 1 column 18"},
+		{"create table t (a vector(3))", 110400, "vectors requires server version 11.7.1, the target is 11.4.0, line 1 column 18"},
+		{"create table t (a int)", 100000, ""},
+		{"create or replace table t (a int)", 100005, "CREATE OR REPLACE TABLE requires server version 10.0.8, the target is 10.0.5, line 1 column 7"},
+		{"create or replace table t (a int)", 100010, ""},
+		{"create or replace user u", 100010, "CREATE OR REPLACE USER requires server version 10.1.3, the target is 10.0.10, line 1 column 7"},
+		{"create or replace database d", 100010, "CREATE OR REPLACE DATABASE requires server version 10.1.3, the target is 10.0.10, line 1 column 7"},
+		{"create or replace server s foreign data wrapper mysql options (host 'h')", 100010, "CREATE OR REPLACE SERVER requires server version 10.1.3, the target is 10.0.10, line 1 column 7"},
+		{"create or replace index i on t (a)", 100103, "CREATE OR REPLACE INDEX requires server version 10.1.4, the target is 10.1.3, line 1 column 7"},
+		{"create or replace role r", 100103, ""},
+		{"create user u", 100010, ""},
+		{"create or replace view v as select 1", 100005, ""},
+		{"create role r", 100000, "roles requires server version 10.0.5, the target is 10.0.0, line 1 column 7"},
+		{"drop role r", 100000, "roles requires server version 10.0.5, the target is 10.0.0, line 1 column 5"},
+		{"drop role if exists r", 100000, "roles requires server version 10.0.5, the target is 10.0.0, line 1 column 5"},
+		{"set role r", 100000, "roles requires server version 10.0.5, the target is 10.0.0, line 1 column 4"},
+		{"set default role r for u", 100000, "roles requires server version 10.0.5, the target is 10.0.0, line 1 column 12"},
+		{"grant r to u", 100000, "roles requires server version 10.0.5, the target is 10.0.0, line 1 column 6"},
+		{"revoke r from u", 100000, "roles requires server version 10.0.5, the target is 10.0.0, line 1 column 7"},
+		{"revoke admin option for r from u", 100000, "roles requires server version 10.0.5, the target is 10.0.0, line 1 column 24"},
+		{"grant select on t to u", 100000, ""},
+		{"select a,\n  sum(b) over (order by a) from t", 100106, "window functions requires server version 10.2.0, the target is 10.1.6, line 2 column 9"},
+		{"show create sequence s", 100211, "sequences requires server version 10.3.1, the target is 10.2.11, line 1 column 12"},
+		{"show create sequence s", 0, ""},
+	}
+	for _, t := range table {
+		parser := New()
+		parser.EnableWindowFunc(true)
+		parser.SetServerVersion(t.version)
+		_, warns, err := parser.Parse(t.src, "", "")
+		c.Assert(err, IsNil, Commentf("source %v", t.src))
+		if t.err == "" {
+			c.Assert(warns, HasLen, 0, Commentf("source %v", t.src))
+		} else {
+			c.Assert(warns, HasLen, 1, Commentf("source %v", t.src))
+			c.Assert(warns[0], ErrorMatches, ".*"+regexp.QuoteMeta(t.err), Commentf("source %v", t.src))
+		}
+
+		parser.EnableStrictVersion(true)
+		_, _, err = parser.Parse(t.src, "", "")
+		if t.err == "" {
+			c.Assert(err, IsNil, Commentf("source %v", t.src))
+		} else {
+			c.Assert(ErrFeatureVersion.Equal(err), IsTrue, Commentf("source %v", t.src))
+			c.Assert(err, ErrorMatches, ".*"+regexp.QuoteMeta(t.err), Commentf("source %v", t.src))
+		}
+	}
+
+	versions := []struct {
+		src     string
+		version int
+	}{
+		{"10.6.11", 100611},
+		{"11.4.2-MariaDB-log", 110402},
+		{"5.7.30", 50730},
+	}
+	for _, t := range versions {
+		version, err := ParseServerVersion(t.src)
+		c.Assert(err, IsNil)
+		c.Assert(version, Equals, t.version)
+		c.Assert(formatServerVersion(version), Equals, strings.SplitN(t.src, "-", 2)[0])
+	}
+	for _, src := range []string{"", "10.6", "10.6.x", "10.100.1"} {
+		_, err := ParseServerVersion(src)
+		c.Assert(err, NotNil, Commentf("source %v", src))
+	}
+}
+
+func (s *testParserSuite) TestServerDefinition(c *C) {
+	table := []testCase{
+		{"create server s foreign data wrapper mysql options (host '127.0.0.1', database 'db', user 'u', password 'p', port 3306, socket '/tmp/mysql.sock', owner 'o')", true, "CREATE SERVER `s` FOREIGN DATA WRAPPER `mysql` OPTIONS (HOST '127.0.0.1', DATABASE 'db', USER 'u', PASSWORD 'p', PORT 3306, SOCKET '/tmp/mysql.sock', OWNER 'o')"},
+		{"CREATE OR REPLACE SERVER 'backend1' FOREIGN DATA WRAPPER 'mysql' OPTIONS (HOST 'h1')", true, "CREATE OR REPLACE SERVER `backend1` FOREIGN DATA WRAPPER `mysql` OPTIONS (HOST 'h1')"},
+		{"create server if not exists s foreign data wrapper mysql options (host 'h1')", true, "CREATE SERVER IF NOT EXISTS `s` FOREIGN DATA WRAPPER `mysql` OPTIONS (HOST 'h1')"},
+		{"create server s foreign data wrapper mysql options ()", false, ""},
+		{"create server s foreign data wrapper mysql", false, ""},
+		{"create server s foreign data wrapper mysql options (port '3306')", false, ""},
+		{"alter server s options (user 'u2', password 'p2')", true, "ALTER SERVER `s` OPTIONS (USER 'u2', PASSWORD 'p2')"},
+		{"drop server s", true, "DROP SERVER `s`"},
+		{"drop server if exists s", true, "DROP SERVER IF EXISTS `s`"},
+		{"select host, port, socket, owner, options, wrapper from server", true, "SELECT `host`,`port`,`socket`,`owner`,`options`,`wrapper` FROM `server`"},
+	}
+	s.RunTest(c, table)
+}
+
 func (s *testParserSuite) TestComment(c *C) {
 	table := []testCase{
 		{"create table t (c int comment 'comment')", true, "CREATE TABLE `t` (`c` INT COMMENT 'comment')"},
@@ -2377,6 +2666,24 @@ func (s *testParserSuite) TestMysqlDump(c *C) {
 	s.RunTest(c, table)
 }
 
+func (s *testParserSuite) TestBackup(c *C) {
+	table := []testCase{
+		{"backup stage start", true, "BACKUP STAGE START"},
+		{"backup stage flush", true, "BACKUP STAGE FLUSH"},
+		{"backup stage block_ddl", true, "BACKUP STAGE BLOCK_DDL"},
+		{"backup stage block_commit", true, "BACKUP STAGE BLOCK_COMMIT"},
+		{"backup stage end", true, "BACKUP STAGE END"},
+		{"backup stage", false, ""},
+		{"backup stage commit", false, ""},
+		{"backup lock t1", true, "BACKUP LOCK `t1`"},
+		{"backup lock db.t1", true, "BACKUP LOCK `db`.`t1`"},
+		{"backup lock", false, ""},
+		{"backup unlock", true, "BACKUP UNLOCK"},
+		{"select backup, stage from t", true, "SELECT `backup`,`stage` FROM `t`"},
+	}
+	s.RunTest(c, table)
+}
+
 func (s *testParserSuite) TestIndexHint(c *C) {
 	table := []testCase{
 		{`select * from t use index (primary)`, true, "SELECT * FROM `t` USE INDEX (`primary`)"},
@@ -2393,6 +2700,43 @@ func (s *testParserSuite) TestIndexHint(c *C) {
 	s.RunTest(c, table)
 }
 
+func (s *testParserSuite) TestGroupByRollup(c *C) {
+	table := []testCase{
+		{"select a, b, sum(c) from t group by a, b with rollup", true, "SELECT `a`,`b`,SUM(`c`) FROM `t` GROUP BY `a`,`b` WITH ROLLUP"},
+		{"select a, sum(c) from t group by a with rollup having a is null limit 10", true, "SELECT `a`,SUM(`c`) FROM `t` GROUP BY `a` WITH ROLLUP HAVING `a` IS NULL LIMIT 10"},
+		{"select a, grouping(a), sum(c) from t group by a WITH   ROLLUP", true, "SELECT `a`,GROUPING(`a`),SUM(`c`) FROM `t` GROUP BY `a` WITH ROLLUP"},
+		{"select grouping(a, b) from t group by a, b with /* subtotals */ rollup", true, "SELECT GROUPING(`a`, `b`) FROM `t` GROUP BY `a`,`b` WITH ROLLUP"},
+		{"(select a from t group by a with rollup) union (select 1) order by a", true, "(SELECT `a` FROM `t` GROUP BY `a` WITH ROLLUP) UNION (SELECT 1) ORDER BY `a`"},
+		{"select rollup from t group by rollup", true, "SELECT `rollup` FROM `t` GROUP BY `rollup`"},
+		{"create view v as select a from t group by a with cascaded check option", true, "CREATE ALGORITHM = UNDEFINED DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS SELECT `a` FROM `t` GROUP BY `a`"},
+		{"create view v as select a from t group by a with rollup with local check option", true, "CREATE ALGORITHM = UNDEFINED DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS SELECT `a` FROM `t` GROUP BY `a` WITH ROLLUP WITH LOCAL CHECK OPTION"},
+		{"select a from t with rollup", false, ""},
+		{"select grouping() from t group by a with rollup", false, ""},
+	}
+	s.RunTest(c, table)
+
+	errTable := []testErrMsgCase{
+		{"select a from t group by a with rollup order by a", false, ErrWrongUsage.GenWithStackByArgs("CUBE/ROLLUP", "ORDER BY")},
+		{"select a from t group by a with rollup limit 1", false, nil},
+	}
+	s.RunErrMsgTest(c, errTable)
+
+	parser := New()
+	stmt, err := parser.ParseOneStmt("select a, grouping(a) from t group by a with rollup", "", "")
+	c.Assert(err, IsNil)
+	sel := stmt.(*ast.SelectStmt)
+	c.Assert(sel.GroupBy.Rollup, IsTrue)
+	c.Assert(ast.HasAggFlag(sel.Fields.Fields[1].Expr), IsTrue)
+
+	// WITH ROLLUP is looked ahead inside executable comments too.
+	stmt, err = parser.ParseOneStmt("/*!40000 select a from t group by a with rollup */", "", "")
+	c.Assert(err, IsNil)
+	c.Assert(stmt.(*ast.SelectStmt).GroupBy.Rollup, IsTrue)
+	stmt, err = parser.ParseOneStmt("/*!40000 create view v as select a from t group by a with local check option */", "", "")
+	c.Assert(err, IsNil)
+	c.Assert(stmt.(*ast.CreateViewStmt).Select.(*ast.SelectStmt).GroupBy.Rollup, IsFalse)
+}
+
 func (s *testParserSuite) TestPriority(c *C) {
 	table := []testCase{
 		{`select high_priority * from t`, true, "SELECT HIGH_PRIORITY * FROM `t`"},
@@ -2491,8 +2835,41 @@ func (s *testParserSuite) TestExplain(c *C) {
 		{"EXPLAIN FOR connection 42", true, "EXPLAIN FORMAT = 'row' FOR CONNECTION 42"},
 		{"EXPLAIN FORMAT = 'dot' FOR CONNECTION 1", true, "EXPLAIN FORMAT = 'dot' FOR CONNECTION 1"},
 		{"EXPLAIN FORMAT = 'row' FOR connection 1", true, "EXPLAIN FORMAT = 'row' FOR CONNECTION 1"},
+		{"EXPLAIN FORMAT = JSON SELECT 1", true, "EXPLAIN FORMAT = 'JSON' SELECT 1"},
+		{"EXPLAIN FORMAT = row SELECT 1", true, "EXPLAIN FORMAT = 'row' SELECT 1"},
+		{"EXPLAIN FORMAT = traditional FOR CONNECTION 1", true, "EXPLAIN FORMAT = 'traditional' FOR CONNECTION 1"},
+		{"EXPLAIN FORMAT = 'tree' SELECT 1", true, "EXPLAIN FORMAT = 'tree' SELECT 1"},
+		{"EXPLAIN EXTENDED SELECT 1", true, "EXPLAIN EXTENDED SELECT 1"},
+		{"EXPLAIN PARTITIONS SELECT * FROM t", true, "EXPLAIN PARTITIONS SELECT * FROM `t`"},
+		{"EXPLAIN extended", true, "DESC `extended`"},
+		{"DESC partitions", true, "DESC `partitions`"},
+		{"ANALYZE SELECT * FROM t WHERE a > 1", true, "ANALYZE SELECT * FROM `t` WHERE `a`>1"},
+		{"ANALYZE FORMAT=JSON UPDATE t SET a = 1", true, "ANALYZE FORMAT = 'JSON' UPDATE `t` SET `a`=1"},
+		{"ANALYZE DELETE FROM t", true, "ANALYZE DELETE FROM `t`"},
+		{"SHOW EXPLAIN FOR 12", true, "SHOW EXPLAIN FOR 12"},
+		{"SHOW EXPLAIN FORMAT=JSON FOR 12", true, "SHOW EXPLAIN FORMAT = 'JSON' FOR 12"},
+		{"EXPLAIN FORMAT = JSON", false, ""},
+		{"SHOW EXPLAIN FOR CONNECTION 12", false, ""},
 	}
 	s.RunTest(c, table)
+
+	errTable := []testErrMsgCase{
+		{"EXPLAIN FORMAT = 'xml' SELECT 1", false, ErrUnknownExplainFormat.GenWithStackByArgs("xml")},
+		{"EXPLAIN FORMAT = yaml FOR CONNECTION 1", false, ErrUnknownExplainFormat.GenWithStackByArgs("yaml")},
+		{"ANALYZE FORMAT = foo SELECT 1", false, ErrUnknownExplainFormat.GenWithStackByArgs("foo")},
+		{"SHOW EXPLAIN FORMAT = bar FOR 1", false, ErrUnknownExplainFormat.GenWithStackByArgs("bar")},
+		{"EXPLAIN FORMAT = Json SELECT 1", false, nil},
+	}
+	s.RunErrMsgTest(c, errTable)
+
+	stmt, err := New().ParseOneStmt("ANALYZE TABLE t", "", "")
+	c.Assert(err, IsNil)
+	_, ok := stmt.(*ast.AnalyzeTableStmt)
+	c.Assert(ok, IsTrue)
+	stmt, err = New().ParseOneStmt("ANALYZE SELECT * FROM t", "", "")
+	c.Assert(err, IsNil)
+	_, ok = stmt.(*ast.AnalyzeStmt)
+	c.Assert(ok, IsTrue)
 }
 
 func (s *testParserSuite) TestPrepare(c *C) {
@@ -2613,6 +2990,19 @@ func (s *testParserSuite) TestSessionManage(c *C) {
 		{"kill 23123", true, "KILL 23123"},
 		{"kill connection 23123", true, "KILL 23123"},
 		{"kill query 23123", true, "KILL QUERY 23123"},
+		// See https://mariadb.com/kb/en/kill/
+		{"kill hard 23123", true, "KILL HARD 23123"},
+		{"kill soft connection 23123", true, "KILL 23123"},
+		{"kill hard query 23123", true, "KILL HARD QUERY 23123"},
+		{"kill query id 42", true, "KILL QUERY ID 42"},
+		{"kill soft query id 42", true, "KILL QUERY ID 42"},
+		{"kill hard query id 42", true, "KILL HARD QUERY ID 42"},
+		{"kill user 'bob'@'localhost'", true, "KILL USER `bob`@`localhost`"},
+		{"kill connection user bob", true, "KILL USER `bob`@`%`"},
+		{"kill hard query user current_user()", true, "KILL HARD QUERY USER CURRENT_USER"},
+		{"kill connection id 42", false, ""},
+		{"kill hard soft 1", false, ""},
+		{"kill user", false, ""},
 		{"show processlist", true, "SHOW PROCESSLIST"},
 		{"show full processlist", true, "SHOW FULL PROCESSLIST"},
 	}
@@ -2639,6 +3029,159 @@ func (s *testParserSuite) TestSQLModeANSIQuotes(c *C) {
 		_, _, err := parser.Parse(test, "", "")
 		c.Assert(err, IsNil)
 	}
+
+	stmt, err := parser.ParseOneStmt(`select "t"."a", "b" from "db"."t" where "a" = 'x' and "b" = "t"."c"`, "", "")
+	c.Assert(err, IsNil)
+	sel := stmt.(*ast.SelectStmt)
+	col := sel.Fields.Fields[0].Expr.(*ast.ColumnNameExpr).Name
+	c.Assert(col.Table.O, Equals, "t")
+	c.Assert(col.Name.O, Equals, "a")
+	c.Assert(sel.Fields.Fields[1].Expr.(*ast.ColumnNameExpr).Name.Name.O, Equals, "b")
+	tbl := sel.From.TableRefs.Left.(*ast.TableSource).Source.(*ast.TableName)
+	c.Assert(tbl.Schema.O, Equals, "db")
+	c.Assert(tbl.Name.O, Equals, "t")
+
+	restoreTests := []struct {
+		src     string
+		restore string
+	}{
+		{`select "a", 'b' from "t"`, `SELECT "a",'b' FROM "t"`},
+		{`select "a""b" from t where c = 'it''s'`, `SELECT "a""b" FROM "t" WHERE "c"='it''s'`},
+		{"insert into `t` (`a`) values ('x')", `INSERT INTO "t" ("a") VALUES ('x')`},
+		{`update "t" set "a" = 'x' where "b" = 1`, `UPDATE "t" SET "a"='x' WHERE "b"=1`},
+	}
+	flags := mysql.ModeANSIQuotes.RestoreFlags(RestoreStringDoubleQuotes | RestoreKeyWordUppercase | RestoreNameBackQuotes)
+	c.Assert(flags, Equals, RestoreStringSingleQuotes|RestoreKeyWordUppercase|RestoreNameDoubleQuotes)
+	c.Assert(mysql.ModeNone.RestoreFlags(DefaultRestoreFlags), Equals, DefaultRestoreFlags)
+	for _, t := range restoreTests {
+		comment := Commentf("source %s", t.src)
+		stmt, err := parser.ParseOneStmt(t.src, "", "")
+		c.Assert(err, IsNil, comment)
+		var sb strings.Builder
+		c.Assert(stmt.Restore(NewRestoreCtx(flags, &sb)), IsNil, comment)
+		c.Assert(sb.String(), Equals, t.restore, comment)
+		restoreStmt, err := parser.ParseOneStmt(sb.String(), "", "")
+		c.Assert(err, IsNil, comment)
+		CleanNodeText(stmt)
+		CleanNodeText(restoreStmt)
+		c.Assert(restoreStmt, DeepEquals, stmt, comment)
+	}
+}
+
+func (s *testParserSuite) TestSQLModeOracle(c *C) {
+	parser := New()
+	parser.SetSQLMode(mysql.ModeOracle)
+	tests := []struct {
+		src     string
+		restore string
+	}{
+		{"select 'a' || 'b'", "SELECT CONCAT('a', 'b')"},
+		{"select decode(a, 1, 'one', 2, 'two', 'other') from t", "SELECT DECODE_ORACLE(`a`, 1, 'one', 2, 'two', 'other') FROM `t`"},
+		{"select nvl(a, 0) from t", "SELECT NVL(`a`, 0) FROM `t`"},
+		{"select rownum, a from t where rownum < 10", "SELECT ROWNUM(),`a` FROM `t` WHERE ROWNUM()<10"},
+	}
+	for _, t := range tests {
+		stmt, err := parser.ParseOneStmt(t.src, "", "")
+		c.Assert(err, IsNil, Commentf("source %s", t.src))
+		var sb strings.Builder
+		c.Assert(stmt.Restore(NewRestoreCtx(DefaultRestoreFlags, &sb)), IsNil)
+		c.Assert(sb.String(), Equals, t.restore, Commentf("source %s", t.src))
+	}
+
+	// PL/SQL stored programs.
+	tests = []struct {
+		src     string
+		restore string
+	}{
+		{"create procedure p as begin null; end", "CREATE PROCEDURE `p` AS BEGIN NULL; END"},
+		{"create procedure p() is begin null; end p", "CREATE PROCEDURE `p` IS BEGIN NULL; END"},
+		{"create or replace procedure db.p(a in int, b out varchar(10), c in out t.c%type, d inout t%rowtype, e int) as begin null; end",
+			"CREATE OR REPLACE PROCEDURE `db`.`p`(`a` IN INT, `b` OUT VARCHAR(10), `c` IN OUT `t`.`c`%TYPE, `d` IN OUT `t`%ROWTYPE, `e` IN INT) AS BEGIN NULL; END"},
+		{"create procedure p as v int := 1; w v%TYPE default 2; name text; begin v := v + 1; w := v % 2; end",
+			"CREATE PROCEDURE `p` AS `v` INT := 1; `w` `v`%TYPE := 2; `name` TEXT; BEGIN `v` := `v`+1; `w` := `v`%2; END"},
+		{"create procedure p(a int) as begin update t set x = a; insert into t values (a); delete from t where x > a; select * from t; commit; end",
+			"CREATE PROCEDURE `p`(`a` IN INT) AS BEGIN UPDATE `t` SET `x`=`a`; INSERT INTO `t` VALUES (`a`); DELETE FROM `t` WHERE `x`>`a`; SELECT * FROM `t`; COMMIT; END"},
+		{"create procedure p as begin declare z int; begin z := 1; end; begin null; end; end",
+			"CREATE PROCEDURE `p` AS BEGIN DECLARE `z` INT; BEGIN `z` := 1; END; BEGIN NULL; END; END"},
+		{"create procedure p as begin null; exception when no_data_found or too_many_rows then rollback; when others then null; end",
+			"CREATE PROCEDURE `p` AS BEGIN NULL; EXCEPTION WHEN `no_data_found` OR `too_many_rows` THEN ROLLBACK; WHEN OTHERS THEN NULL; END"},
+		{"create package pkg as v int; procedure p(a int); procedure q; end pkg",
+			"CREATE PACKAGE `pkg` AS `v` INT; PROCEDURE `p`(`a` IN INT); PROCEDURE `q`; END"},
+		{"create or replace package body pkg is v int := 0; procedure p(a int) as begin v := a; end p; procedure q is w int; begin null; end; end",
+			"CREATE OR REPLACE PACKAGE BODY `pkg` IS `v` INT := 0; PROCEDURE `p`(`a` IN INT) AS BEGIN `v` := `a`; END; PROCEDURE `q` IS `w` INT; BEGIN NULL; END; END"},
+		{"create package body as end", "CREATE PACKAGE `body` AS END"},
+		{"create procedure p(a in number, b in varchar2, c out raw, d varchar2 binary, e varchar, f varbinary) as x number(5,1); y clob; begin null; end",
+			"CREATE PROCEDURE `p`(`a` IN DOUBLE, `b` IN VARCHAR, `c` OUT VARBINARY, `d` IN VARCHAR BINARY, `e` IN VARCHAR, `f` IN VARBINARY) AS `x` DECIMAL(5,1); `y` LONGTEXT; BEGIN NULL; END"},
+		{"create table t (a number(10,2), b varchar2(10), c raw(16), d clob, e number)",
+			"CREATE TABLE `t` \n(\n`a` DECIMAL(10,2),\n`b` VARCHAR(10),\n`c` VARBINARY(16),\n`d` LONGTEXT,\n`e` DOUBLE\n)\n"},
+		{"select number, varchar2, raw, clob from number", "SELECT `number`,`varchar2`,`raw`,`clob` FROM `number`"},
+	}
+	for _, t := range tests {
+		stmt, err := parser.ParseOneStmt(t.src, "", "")
+		c.Assert(err, IsNil, Commentf("source %s", t.src))
+		var sb strings.Builder
+		c.Assert(stmt.Restore(NewRestoreCtx(DefaultRestoreFlags, &sb)), IsNil)
+		c.Assert(sb.String(), Equals, t.restore, Commentf("source %s", t.src))
+		restoreStmt, err := parser.ParseOneStmt(sb.String(), "", "")
+		c.Assert(err, IsNil, Commentf("source %s", t.src))
+		CleanNodeText(stmt)
+		CleanNodeText(restoreStmt)
+		c.Assert(restoreStmt, DeepEquals, stmt, Commentf("source %s", t.src))
+	}
+
+	for _, src := range []string{
+		"create procedure p as begin end",
+		"create procedure p as begin null; end q",
+		"create procedure p as begin v := 1 end",
+		"create procedure p as begin int; begin null; end",
+		"create procedure p as end int; begin null; end",
+		"create package pkg as procedure p as begin null; end; end",
+		"create package body pkg as procedure p as begin null; end q; end",
+		"create table t (a varchar2)",
+		"create procedure p as v raw; begin null; end",
+	} {
+		_, err := parser.ParseOneStmt(src, "", "")
+		c.Assert(err, NotNil, Commentf("source %s", src))
+	}
+
+	parser.SetServerVersion(100211)
+	_, warns, err := parser.Parse("create procedure p as begin null; end", "", "")
+	c.Assert(err, IsNil)
+	c.Assert(warns, HasLen, 1)
+	c.Assert(warns[0], ErrorMatches, ".*sql_mode=ORACLE stored procedures requires server version 10.3.0, the target is 10.2.11, line 1 column 7")
+	parser.SetServerVersion(100304)
+	_, warns, err = parser.Parse("create package pkg as end", "", "")
+	c.Assert(err, IsNil)
+	c.Assert(warns, HasLen, 1)
+	c.Assert(warns[0], ErrorMatches, ".*packages requires server version 10.3.5, the target is 10.3.4, line 1 column 7")
+	parser.SetServerVersion(0)
+
+	// Without Oracle mode DECODE and ROWNUM keep their MySQL meaning.
+	parser.SetSQLMode(mysql.ModeNone)
+	stmt, err := parser.ParseOneStmt("select decode(a, 'k'), rownum from t", "", "")
+	c.Assert(err, IsNil)
+	var sb strings.Builder
+	c.Assert(stmt.Restore(NewRestoreCtx(DefaultRestoreFlags, &sb)), IsNil)
+	c.Assert(sb.String(), Equals, "SELECT DECODE(`a`, 'k'),`rownum` FROM `t`")
+
+	// So are the PL/SQL stored programs and their keywords.
+	_, err = parser.ParseOneStmt("create procedure p as begin null; end", "", "")
+	c.Assert(err, ErrorMatches, ".*CREATE PROCEDURE ... AS is only supported when sql_mode=ORACLE is set.*")
+	_, err = parser.ParseOneStmt("create package pkg as end", "", "")
+	c.Assert(err, ErrorMatches, ".*CREATE PACKAGE ... AS is only supported when sql_mode=ORACLE is set.*")
+	for key := range oracleTokenMap {
+		_, err = parser.ParseOneStmt(fmt.Sprintf("select %s from t", key), "", "")
+		c.Assert(err, IsNil, Commentf("keyword %s", key))
+	}
+	_, err = parser.ParseOneStmt("create table t (a number(10,2))", "", "")
+	c.Assert(err, NotNil)
+	parser.SetSQLMode(mysql.ModeOracle)
+	for _, key := range []string{"DECLARE", "EXCEPTION", "INOUT", "OUT"} {
+		_, err = parser.ParseOneStmt(fmt.Sprintf("select %s from t", key), "", "")
+		c.Assert(err, NotNil, Commentf("keyword %s", key))
+	}
+	_, err = parser.ParseOneStmt("create table t (a number(10,2))", "", "")
+	c.Assert(err, IsNil)
 }
 
 func (s *testParserSuite) TestDDLStatements(c *C) {
@@ -2708,15 +3251,400 @@ func (s *testParserSuite) TestDDLStatements(c *C) {
 	c.Assert(err, IsNil)
 }
 
+func (s *testParserSuite) TestAlterTableIfExists(c *C) {
+	table := []testCase{
+		{"alter table if exists t add column if not exists c int", true, "ALTER TABLE IF EXISTS `t` ADD COLUMN IF NOT EXISTS `c` INT"},
+		{"alter table t add if not exists (c int, d int)", true, "ALTER TABLE `t` ADD COLUMN IF NOT EXISTS (`c` INT, `d` INT)"},
+		{"alter table t add key if not exists k(c)", true, "ALTER TABLE `t` ADD INDEX IF NOT EXISTS `k`(`c`)"},
+		{"alter table t add unique if not exists u(c)", true, "ALTER TABLE `t` ADD UNIQUE IF NOT EXISTS `u`(`c`)"},
+		{"alter table t add fulltext index if not exists f(c)", true, "ALTER TABLE `t` ADD FULLTEXT IF NOT EXISTS `f`(`c`)"},
+		{"alter table t add constraint fk foreign key if not exists (c) references p(id)", true, "ALTER TABLE `t` ADD CONSTRAINT `fk` FOREIGN KEY IF NOT EXISTS (`c`) REFERENCES `p`(`id`)"},
+		{"alter table t add partition if not exists (partition p1 values less than (10))", true, "ALTER TABLE `t` ADD PARTITION IF NOT EXISTS (PARTITION `p1` VALUES LESS THAN (10))"},
+		{"alter table t drop index if exists i", true, "ALTER TABLE `t` DROP INDEX IF EXISTS `i`"},
+		{"alter table t drop key if exists i", true, "ALTER TABLE `t` DROP INDEX IF EXISTS `i`"},
+		{"alter table t drop foreign key if exists fk", true, "ALTER TABLE `t` DROP FOREIGN KEY IF EXISTS `fk`"},
+		{"alter table t drop column if exists c", true, "ALTER TABLE `t` DROP COLUMN IF EXISTS `c`"},
+		{"alter table t drop if exists c", true, "ALTER TABLE `t` DROP COLUMN IF EXISTS `c`"},
+		{"alter table t drop partition if exists p1", true, "ALTER TABLE `t` DROP PARTITION IF EXISTS `p1`"},
+		{"alter table t change column if exists a b int", true, "ALTER TABLE `t` CHANGE COLUMN IF EXISTS `a` `b` INT"},
+		{"alter table t modify if exists a bigint first", true, "ALTER TABLE `t` MODIFY COLUMN IF EXISTS `a` BIGINT FIRST"},
+		{"alter table t alter column if exists a set default 1", true, "ALTER TABLE `t` ALTER COLUMN IF EXISTS `a` SET DEFAULT 1"},
+		{"alter table t alter if exists a drop default", true, "ALTER TABLE `t` ALTER COLUMN IF EXISTS `a` DROP DEFAULT"},
+		{"alter table t rename index if exists i to j", true, "ALTER TABLE `t` RENAME INDEX IF EXISTS `i` TO `j`"},
+		{"alter table t rename key if exists i to j", true, "ALTER TABLE `t` RENAME INDEX IF EXISTS `i` TO `j`"},
+		{"alter table t add index if not exists (c)", true, "ALTER TABLE `t` ADD INDEX IF NOT EXISTS (`c`)"},
+		{"alter table t drop index if exists i, drop column if exists c", true, "ALTER TABLE `t` DROP INDEX IF EXISTS `i`,\nDROP COLUMN IF EXISTS `c`"},
+		{"alter table t discard tablespace", true, "ALTER TABLE `t` DISCARD TABLESPACE"},
+		{"alter table t import tablespace", true, "ALTER TABLE `t` IMPORT TABLESPACE"},
+		{"alter table t order by a, b desc, c asc", true, "ALTER TABLE `t` ORDER BY `a`, `b` DESC, `c`"},
+		{"alter table t convert to character set default", true, "ALTER TABLE `t` CONVERT TO CHARACTER SET DEFAULT"},
+		{"alter table t convert to charset default collate utf8_bin", true, "ALTER TABLE `t` CONVERT TO CHARACTER SET DEFAULT COLLATE utf8_bin"},
+		{"alter table t add column discard int", true, "ALTER TABLE `t` ADD COLUMN `discard` INT"},
+		{"alter table if not exists t add column c int", false, ""},
+		{"alter table t drop primary key if exists", false, ""},
+		{"alter table t add column if exists c int", false, ""},
+	}
+	s.RunTest(c, table)
+}
+
+func (s *testParserSuite) TestSetStatement(c *C) {
+	table := []testCase{
+		{"set statement max_statement_time=5 for select * from t", true, "SET STATEMENT `max_statement_time`=5 FOR SELECT * FROM `t`"},
+		{"SET STATEMENT max_statement_time=5, optimizer_switch='index_merge=off' FOR SELECT 1", true, "SET STATEMENT `max_statement_time`=5, `optimizer_switch`='index_merge=off' FOR SELECT 1"},
+		{"set statement sort_buffer_size = default for update t set a = 1", true, "SET STATEMENT `sort_buffer_size`=DEFAULT FOR UPDATE `t` SET `a`=1"},
+		{"set statement sql_mode = 'ansi' for insert into t values (1)", true, "SET STATEMENT `sql_mode`='ansi' FOR INSERT INTO `t` VALUES (1)"},
+		{"set statement x=1 for replace into t values (1)", true, "SET STATEMENT `x`=1 FOR REPLACE INTO `t` VALUES (1)"},
+		{"set statement x=1 for delete from t where a = 1", true, "SET STATEMENT `x`=1 FOR DELETE FROM `t` WHERE `a`=1"},
+		{"set statement x=1 for select 1 union select 2", true, "SET STATEMENT `x`=1 FOR SELECT 1 UNION SELECT 2"},
+		{"set statement = 1", true, "SET @@SESSION.`statement`=1"},
+		{"set statement for select 1", false, ""},
+		{"set statement @a=1 for select 1", false, ""},
+		{"set statement x=1 for show tables", false, ""},
+		{"set statement x=1", false, ""},
+	}
+	s.RunTest(c, table)
+
+	stmt, err := New().ParseOneStmt("set statement max_statement_time=5 for select a from t where b = 1", "", "")
+	c.Assert(err, IsNil)
+	setStmt, ok := stmt.(*ast.SetStatementStmt)
+	c.Assert(ok, IsTrue)
+	c.Assert(setStmt.Variables, HasLen, 1)
+	c.Assert(setStmt.Variables[0].Name, Equals, "max_statement_time")
+	c.Assert(ast.IsReadOnly(setStmt), IsTrue)
+	checker := &columnNameCollector{}
+	stmt.Accept(checker)
+	// SelectStmt visits the where clause before the fields.
+	c.Assert(strings.Join(checker.names, ","), Equals, "b,a")
+}
+
+func (s *testParserSuite) TestTableValueConstructor(c *C) {
+	table := []testCase{
+		{"values (1, 'a'), (2, 'b')", true, "VALUES (1,'a'),(2,'b')"},
+		{"values (1, 2) order by 1 limit 1", true, "VALUES (1,2) ORDER BY 1 LIMIT 1"},
+		{"values", false, ""},
+		{"values 1, 2", false, ""},
+		{"select 1 union values (2)", true, "SELECT 1 UNION VALUES (2)"},
+		{"values (1) union all values (2) union select 3", true, "VALUES (1) UNION ALL VALUES (2) UNION SELECT 3"},
+		{"(values (1)) union (values (2))", true, "(VALUES (1)) UNION (VALUES (2))"},
+		{"select * from t where a in (values (1), (2))", true, "SELECT * FROM `t` WHERE `a` IN (VALUES (1),(2))"},
+		{"select * from (values (1, 'a'), (2, 'b')) as t(c1, c2)", true, "SELECT * FROM (VALUES (1,'a'),(2,'b')) AS `t`(`c1`, `c2`)"},
+		{"select * from (values (1)) t", true, "SELECT * FROM (VALUES (1)) AS `t`"},
+		{"select * from (select 1, 2) as t(a, b)", true, "SELECT * FROM (SELECT 1,2) AS `t`(`a`, `b`)"},
+		{"select * from (select 1 union select 2) as t(a)", true, "SELECT * FROM (SELECT 1 UNION SELECT 2) AS `t`(`a`)"},
+		{"create view v as values (1)", true, "CREATE ALGORITHM = UNDEFINED DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS VALUES (1)"},
+		// A parenthesized single identifier is still the VALUES() function.
+		{"select (values (a))", true, "SELECT (VALUES(`a`))"},
+	}
+	s.RunTest(c, table)
+
+	parser := New()
+	stmt, err := parser.ParseOneStmt("insert into t (a) values (1), (2)", "", "")
+	c.Assert(err, IsNil)
+	insert := stmt.(*ast.InsertStmt)
+	c.Assert(insert.Select, IsNil)
+	c.Assert(insert.Lists, HasLen, 2)
+
+	stmt, err = parser.ParseOneStmt("values (1, 2)", "", "")
+	c.Assert(err, IsNil)
+	sel := stmt.(*ast.SelectStmt)
+	c.Assert(sel.Kind, Equals, ast.SelectStmtKindValues)
+	c.Assert(sel.Lists, HasLen, 1)
+	c.Assert(sel.Lists[0], HasLen, 2)
+}
+
+func (s *testParserSuite) TestEngineAttributes(c *C) {
+	table := []testCase{
+		{"alter table t table_type=csv", true, "ALTER TABLE `t` `table_type` = `csv`"},
+		{"alter table t file_name='x.csv'", true, "ALTER TABLE `t` `file_name` = 'x.csv'"},
+		{"alter table t header=1", true, "ALTER TABLE `t` `header` = 1"},
+		{"alter table t my_opt=default", true, "ALTER TABLE `t` `my_opt` = DEFAULT"},
+		{"alter table t my_opt", false, ""},
+		{"alter table t my_opt 1", false, ""},
+		{"alter table t `my opt`='x'", true, "ALTER TABLE `t` `my opt` = 'x'"},
+		{"alter table t page_compressed=1", true, "ALTER TABLE `t` PAGE_COMPRESSED = 1"},
+		{"alter table t page_compression_level 9", true, "ALTER TABLE `t` PAGE_COMPRESSION_LEVEL = 9"},
+		{"alter table t encrypted=yes", true, "ALTER TABLE `t` ENCRYPTED = YES"},
+		{"alter table t encrypted no", true, "ALTER TABLE `t` ENCRYPTED = NO"},
+		{"alter table t encrypted=1", false, ""},
+		{"alter table t encryption_key_id=2", true, "ALTER TABLE `t` ENCRYPTION_KEY_ID = 2"},
+		{"alter table t add column c int not null field_format='%d' comment 'c'", true, "ALTER TABLE `t` ADD COLUMN `c` INT NOT NULL `field_format` = '%d' COMMENT 'c'"},
+		{"create index i on t (a) my_idx_opt=1 comment 'c'", true, "CREATE INDEX `i` ON `t` (`a`) COMMENT 'c' `my_idx_opt` = 1"},
+		{"create index i on t (a) o1=1 o2=abc", true, "CREATE INDEX `i` ON `t` (`a`) `o1` = 1 `o2` = `abc`"},
+		{"select yes, encrypted, page_compressed from t", true, "SELECT `yes`,`encrypted`,`page_compressed` FROM `t`"},
+	}
+	s.RunTest(c, table)
+
+	parser := New()
+	stmt, err := parser.ParseOneStmt("create table t (a int field_format='%d', key k (a) flag=1) engine=connect table_type=csv page_compressed=1 encrypted=yes", "", "")
+	c.Assert(err, IsNil)
+	ct := stmt.(*ast.CreateTableStmt)
+	colOpt := ct.Cols[0].Options[0]
+	c.Assert(colOpt.Tp, Equals, ast.ColumnOptionEngineAttribute)
+	c.Assert(*colOpt.EngineAttribute, Equals, model.EngineAttribute{Name: "field_format", Tp: model.EngineAttributeValueString, Value: "%d"})
+	idxAttrs := ct.Constraints[0].Option.EngineAttributes
+	c.Assert(idxAttrs, HasLen, 1)
+	c.Assert(*idxAttrs[0], Equals, model.EngineAttribute{Name: "flag", Tp: model.EngineAttributeValueNumber, Value: "1"})
+	c.Assert(ct.Options, HasLen, 4)
+	c.Assert(ct.Options[1].Tp, Equals, ast.TableOptionEngineAttribute)
+	c.Assert(*ct.Options[1].EngineAttribute, Equals, model.EngineAttribute{Name: "table_type", Tp: model.EngineAttributeValueIdent, Value: "csv"})
+	c.Assert(ct.Options[2].Tp, Equals, ast.TableOptionPageCompressed)
+	c.Assert(ct.Options[2].UintValue, Equals, uint64(1))
+	c.Assert(ct.Options[3].Tp, Equals, ast.TableOptionEncrypted)
+	c.Assert(ct.Options[3].UintValue, Equals, uint64(1))
+}
+
+func (s *testParserSuite) TestMariaDBColumnTypes(c *C) {
+	table := []testCase{
+		{"alter table t add column a inet6", true, "ALTER TABLE `t` ADD COLUMN `a` INET6"},
+		{"alter table t add column a uuid not null", true, "ALTER TABLE `t` ADD COLUMN `a` UUID NOT NULL"},
+		{"alter table t add column a inet6(16)", false, ""},
+		{"alter table t add column a blob compressed", true, "ALTER TABLE `t` ADD COLUMN `a` BLOB COMPRESSED"},
+		{"alter table t add column a text compressed=zlib null", true, "ALTER TABLE `t` ADD COLUMN `a` TEXT COMPRESSED NULL"},
+		{"alter table t add column a varchar(10) not null compressed", true, "ALTER TABLE `t` ADD COLUMN `a` VARCHAR(10) COMPRESSED NOT NULL"},
+		{"alter table t modify a varbinary(10) compressed", true, "ALTER TABLE `t` MODIFY COLUMN `a` VARBINARY(10) COMPRESSED"},
+		{"alter table t add column a text compressed=lz4", false, ""},
+		{"alter table t add column a int compressed", false, ""},
+		{"alter table t add column a char(10) compressed", false, ""},
+		{"select uuid(), inet6, uuid from t", true, "SELECT UUID(),`inet6`,`uuid` FROM `t`"},
+	}
+	s.RunTest(c, table)
+
+	parser := New()
+	stmt, err := parser.ParseOneStmt("create table t (a inet6, b text compressed)", "", "")
+	c.Assert(err, IsNil)
+	ct := stmt.(*ast.CreateTableStmt)
+	c.Assert(ct.Cols[0].Tp.Tp, Equals, mysql.TypeInet6)
+	c.Assert(ct.Cols[0].Tp.CompactStr(), Equals, "inet6")
+	c.Assert(ct.Cols[1].Tp.Compressed, IsTrue)
+	c.Assert(ct.Cols[1].Options, HasLen, 0)
+	c.Assert(ct.Cols[1].Tp.InfoSchemaStr(), Equals, "text /*M!100301 COMPRESSED*/")
+}
+
+func (s *testParserSuite) TestVector(c *C) {
+	table := []testCase{
+		{"alter table t add column v vector(3) not null", true, "ALTER TABLE `t` ADD COLUMN `v` VECTOR(3) NOT NULL"},
+		{"alter table t add column v vector", false, ""},
+		{"alter table t add column v vector(0)", false, ""},
+		{"alter table t add column v vector(65533)", false, ""},
+		{"alter table t add column vector vector(5)", true, "ALTER TABLE `t` ADD COLUMN `vector` VECTOR(5)"},
+		{"alter table t add vector index (v)", true, "ALTER TABLE `t` ADD VECTOR INDEX(`v`)"},
+		{"alter table t add vector key if not exists i (v) m=8 distance=cosine", true, "ALTER TABLE `t` ADD VECTOR INDEX IF NOT EXISTS `i`(`v`) M = 8 DISTANCE = COSINE"},
+		{"alter table t add vector index i (v) distance=euclidean comment 'c'", true, "ALTER TABLE `t` ADD VECTOR INDEX `i`(`v`) COMMENT 'c' DISTANCE = EUCLIDEAN"},
+		// A column named vector, see Scanner.peekKeyOrIndex.
+		{"alter table t add vector int", true, "ALTER TABLE `t` ADD COLUMN `vector` INT"},
+		{"alter table t add column vector int", true, "ALTER TABLE `t` ADD COLUMN `vector` INT"},
+		{"alter table t add vector vector(3) first", true, "ALTER TABLE `t` ADD COLUMN `vector` VECTOR(3) FIRST"},
+		{"alter table t drop vector", true, "ALTER TABLE `t` DROP COLUMN `vector`"},
+		{"alter table t add vector index i (v) m=2", false, ""},
+		{"alter table t add vector index i (v) m=201", false, ""},
+		{"alter table t add vector index i (v) distance=manhattan", false, ""},
+		{"alter table t add vector index i (v) distance='cosine'", false, ""},
+		{"create vector index i on t (v) m=10 distance=cosine", true, "CREATE VECTOR INDEX `i` ON `t` (`v`) M = 10 DISTANCE = COSINE"},
+		{"create vector index i on t (v)", true, "CREATE VECTOR INDEX `i` ON `t` (`v`)"},
+		{"select vec_distance_euclidean(v, vec_fromtext('[1,2]')) as d from t order by d limit 3", true, "SELECT VEC_DISTANCE_EUCLIDEAN(`v`, VEC_FROMTEXT('[1,2]')) AS `d` FROM `t` ORDER BY `d` LIMIT 3"},
+		{"select vec_totext(v), vec_distance_cosine(v, x'0000803f') from t", true, "SELECT VEC_TOTEXT(`v`),VEC_DISTANCE_COSINE(`v`, x'0000803f') FROM `t`"},
+		{"select vector from vector", true, "SELECT `vector` FROM `vector`"},
+	}
+	s.RunTest(c, table)
+
+	parser := New()
+	stmt, err := parser.ParseOneStmt("create table t (v vector(4) not null, vector index i (v) m=6 distance=cosine)", "", "")
+	c.Assert(err, IsNil)
+	ct := stmt.(*ast.CreateTableStmt)
+	c.Assert(ct.Cols[0].Tp.Tp, Equals, mysql.TypeVector)
+	c.Assert(ct.Cols[0].Tp.Flen, Equals, 4)
+	c.Assert(ct.Cols[0].Tp.CompactStr(), Equals, "vector(4)")
+	c.Assert(ct.Constraints[0].Tp, Equals, ast.ConstraintVector)
+	c.Assert(ct.Constraints[0].Option.EngineAttributes, HasLen, 0)
+	c.Assert(*ct.Constraints[0].Option.VectorInfo, Equals, model.VectorIndexInfo{M: 6, Distance: model.VectorDistanceCosine})
+
+	// MariaDB dumps write vector indexes in executable comments.
+	stmt, err = parser.ParseOneStmt("/*M!110700 create table t (v vector(3), vector index (v)) */", "", "")
+	c.Assert(err, IsNil)
+	ct = stmt.(*ast.CreateTableStmt)
+	c.Assert(ct.Constraints, HasLen, 1)
+	c.Assert(ct.Constraints[0].Tp, Equals, ast.ConstraintVector)
+	stmt, err = parser.ParseOneStmt("/*M!110700 alter table t add vector index (v), add vector int */", "", "")
+	c.Assert(err, IsNil)
+	specs := stmt.(*ast.AlterTableStmt).Specs
+	c.Assert(specs, HasLen, 2)
+	c.Assert(specs[0].Constraint.Tp, Equals, ast.ConstraintVector)
+	c.Assert(specs[1].NewColumns[0].Name.Name.O, Equals, "vector")
+}
+
+type columnNameCollector struct {
+	names []string
+}
+
+// Enter implements ast.Visitor interface.
+func (cc *columnNameCollector) Enter(in ast.Node) (ast.Node, bool) {
+	if name, ok := in.(*ast.ColumnName); ok {
+		cc.names = append(cc.names, name.Name.O)
+	}
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (cc *columnNameCollector) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+func (s *testParserSuite) TestShowMariaDB(c *C) {
+	table := []testCase{
+		{"show create procedure db.p", true, "SHOW CREATE PROCEDURE `db`.`p`"},
+		{"show create function f", true, "SHOW CREATE FUNCTION `f`"},
+		{"show create trigger tr", true, "SHOW CREATE TRIGGER `tr`"},
+		{"show create event db.e", true, "SHOW CREATE EVENT `db`.`e`"},
+		{"show create sequence s", true, "SHOW CREATE SEQUENCE `s`"},
+		{"show create package pkg", true, "SHOW CREATE PACKAGE `pkg`"},
+		{"show create package body pkg", true, "SHOW CREATE PACKAGE BODY `pkg`"},
+		{"show function status", true, "SHOW FUNCTION STATUS"},
+		{"show function status like 'f%'", true, "SHOW FUNCTION STATUS LIKE 'f%'"},
+		{"show function status where db = 'test'", true, "SHOW FUNCTION STATUS WHERE `db`='test'"},
+		{"show procedure code p", true, "SHOW PROCEDURE CODE `p`"},
+		{"show function code db.f", true, "SHOW FUNCTION CODE `db`.`f`"},
+		{"show engine innodb status", true, "SHOW ENGINE `innodb` STATUS"},
+		{"show engine InnoDB mutex", true, "SHOW ENGINE `InnoDB` MUTEX"},
+		{"show engine performance_schema status", true, "SHOW ENGINE `performance_schema` STATUS"},
+		{"show engine `my engine` status", true, "SHOW ENGINE `my engine` STATUS"},
+		{"show engine all status", true, "SHOW ENGINE ALL STATUS"},
+		{"show engine all mutex", true, "SHOW ENGINE ALL MUTEX"},
+		{"show storage engines", true, "SHOW ENGINES"},
+		{"show storage engines where engine = 'InnoDB'", true, "SHOW ENGINES WHERE `engine`='InnoDB'"},
+		{"show binary logs", true, "SHOW BINARY LOGS"},
+		{"show master logs", true, "SHOW BINARY LOGS"},
+		{"show table_statistics", true, "SHOW TABLE_STATISTICS"},
+		{"show index_statistics like 't%'", true, "SHOW INDEX_STATISTICS LIKE 't%'"},
+		{"show user_statistics where user = 'root'", true, "SHOW USER_STATISTICS WHERE `user`='root'"},
+		{"show client_statistics", true, "SHOW CLIENT_STATISTICS"},
+		{"show query_response_time", true, "SHOW QUERY_RESPONSE_TIME"},
+		{"show locales like 'en%'", true, "SHOW LOCALES LIKE 'en%'"},
+		{"show authors", true, "SHOW AUTHORS"},
+		{"show contributors", true, "SHOW CONTRIBUTORS"},
+		{"show wsrep_status", true, "SHOW WSREP_STATUS"},
+		{"show wsrep_membership like 'node%'", true, "SHOW WSREP_MEMBERSHIP LIKE 'node%'"},
+		{"select code, body, sequence, package, storage, mutex, locales from authors", true, "SELECT `code`,`body`,`sequence`,`package`,`storage`,`mutex`,`locales` FROM `authors`"},
+		{"show authors like 'a%'", false, ""},
+		{"show binary logs where 1", false, ""},
+		{"show engine innodb", false, ""},
+		{"show create procedure", false, ""},
+	}
+	s.RunTest(c, table)
+
+	parser := New()
+	stmt, err := parser.ParseOneStmt("show create procedure db.p", "", "")
+	c.Assert(err, IsNil)
+	show := stmt.(*ast.ShowStmt)
+	c.Assert(show.Table, IsNil)
+	c.Assert(show.Object, DeepEquals, &ast.ObjectName{Schema: model.NewCIStr("db"), Name: model.NewCIStr("p")})
+}
+
+func (s *testParserSuite) TestPluginStmt(c *C) {
+	table := []testCase{
+		{"install plugin server_audit soname 'server_audit.so'", true, "INSTALL PLUGIN `server_audit` SONAME 'server_audit.so'"},
+		{"install plugin if not exists ed25519 soname 'auth_ed25519'", true, "INSTALL PLUGIN IF NOT EXISTS `ed25519` SONAME 'auth_ed25519'"},
+		{"install soname 'auth_ed25519'", true, "INSTALL SONAME 'auth_ed25519'"},
+		{"uninstall plugin server_audit", true, "UNINSTALL PLUGIN `server_audit`"},
+		{"uninstall plugin if exists server_audit", true, "UNINSTALL PLUGIN IF EXISTS `server_audit`"},
+		{"uninstall soname 'auth_ed25519'", true, "UNINSTALL SONAME 'auth_ed25519'"},
+		{"uninstall soname if exists 'auth_ed25519'", true, "UNINSTALL SONAME IF EXISTS 'auth_ed25519'"},
+		{"select install, uninstall, plugin, soname from plugin", true, "SELECT `install`,`uninstall`,`plugin`,`soname` FROM `plugin`"},
+		{"install plugin server_audit", false, ""},
+		{"install soname server_audit", false, ""},
+		{"uninstall plugin 'server_audit'", false, ""},
+	}
+	s.RunTest(c, table)
+}
+
+func (s *testParserSuite) TestOnlineDDLOptions(c *C) {
+	table := []testCase{
+		{"alter table t add column c int, algorithm = nocopy", true, "ALTER TABLE `t` ADD COLUMN `c` INT,\nALGORITHM = NOCOPY"},
+		{"alter table t algorithm instant, lock none", true, "ALTER TABLE `t` ALGORITHM = INSTANT,\nLOCK = NONE"},
+		{"create index i on t (a) algorithm = inplace", true, "CREATE INDEX `i` ON `t` (`a`) ALGORITHM = INPLACE"},
+		{"create index i on t (a) lock shared algorithm copy", true, "CREATE INDEX `i` ON `t` (`a`) ALGORITHM = COPY LOCK = SHARED"},
+		{"create unique index i on t (a) using btree algorithm = nocopy lock = exclusive", true, "CREATE UNIQUE INDEX `i` ON `t` (`a`) USING BTREE ALGORITHM = NOCOPY LOCK = EXCLUSIVE"},
+		{"drop index i on t lock = default", true, "DROP INDEX `i` ON `t` LOCK = DEFAULT"},
+		{"drop index if exists i on t algorithm = instant lock = none", true, "DROP INDEX IF EXISTS `i` ON `t` ALGORITHM = INSTANT LOCK = NONE"},
+		{"create index i on t (a) algorithm = default", true, "CREATE INDEX `i` ON `t` (`a`) ALGORITHM = DEFAULT"},
+		{"create index i on t (a) algorithm = default lock = none", true, "CREATE INDEX `i` ON `t` (`a`) ALGORITHM = DEFAULT LOCK = NONE"},
+		{"drop index i on t algorithm default", true, "DROP INDEX `i` ON `t` ALGORITHM = DEFAULT"},
+		{"drop index i on t lock = none algorithm = default", true, "DROP INDEX `i` ON `t` ALGORITHM = DEFAULT LOCK = NONE"},
+		{"create index i on t (a) lock = none lock = none", false, ""},
+	}
+	s.RunTest(c, table)
+
+	errTable := []testErrMsgCase{
+		{"alter table t algorithm = fast", false, ErrUnknownAlterAlgorithm.GenWithStackByArgs("fast")},
+		{"alter table t lock = foo", false, ErrUnknownAlterLock.GenWithStackByArgs("foo")},
+		{"create index i on t (a) algorithm = bar", false, ErrUnknownAlterAlgorithm.GenWithStackByArgs("bar")},
+		{"drop index i on t lock = partial", false, ErrUnknownAlterLock.GenWithStackByArgs("partial")},
+		{"drop index i on t algorithm = nocopy", false, nil},
+	}
+	s.RunErrMsgTest(c, errTable)
+
+	parser := New()
+	stmt, err := parser.ParseOneStmt("alter table t lock = shared, algorithm = copy, add column c int, algorithm = inplace", "", "")
+	c.Assert(err, IsNil)
+	lockAlg := stmt.(*ast.AlterTableStmt).LockAndAlgorithm()
+	c.Assert(lockAlg, NotNil)
+	c.Assert(lockAlg.LockType, Equals, ast.LockTypeShared)
+	c.Assert(lockAlg.Algorithm, Equals, ast.AlterAlgorithmInplace)
+	stmt, err = parser.ParseOneStmt("alter table t add column c int", "", "")
+	c.Assert(err, IsNil)
+	c.Assert(stmt.(*ast.AlterTableStmt).LockAndAlgorithm(), IsNil)
+
+	// A LockAndAlgorithm without any clause restores to nothing.
+	stmt, err = parser.ParseOneStmt("drop index i on t", "", "")
+	c.Assert(err, IsNil)
+	stmt.(*ast.DropIndexStmt).LockAlg = &ast.LockAndAlgorithm{}
+	var sb strings.Builder
+	c.Assert(stmt.Restore(NewRestoreCtx(DefaultRestoreFlags, &sb)), IsNil)
+	c.Assert(sb.String(), Equals, "DROP INDEX `i` ON `t`")
+}
+
 func (s *testParserSuite) TestAnalyze(c *C) {
 	table := []testCase{
 		{"analyze table t1", true, "ANALYZE TABLE `t1`"},
 		{"analyze table t,t1", true, "ANALYZE TABLE `t`,`t1`"},
+		{"analyze local table t1", true, "ANALYZE NO_WRITE_TO_BINLOG TABLE `t1`"},
+		{"analyze no_write_to_binlog table t1", true, "ANALYZE NO_WRITE_TO_BINLOG TABLE `t1`"},
+		{"analyze table t1 persistent for all", true, "ANALYZE TABLE `t1` PERSISTENT FOR ALL"},
+		{"analyze table t1 persistent for columns (a,b) indexes (primary,i)", true, "ANALYZE TABLE `t1` PERSISTENT FOR COLUMNS (`a`,`b`) INDEXES (`primary`,`i`)"},
+		{"analyze table t1 persistent for columns () indexes ()", true, "ANALYZE TABLE `t1` PERSISTENT FOR COLUMNS () INDEXES ()"},
+		{"analyze table t1 persistent for columns all indexes ()", true, "ANALYZE TABLE `t1` PERSISTENT FOR COLUMNS ALL INDEXES ()"},
+		{"analyze table t1 persistent for columns (a) indexes all", true, "ANALYZE TABLE `t1` PERSISTENT FOR COLUMNS (`a`) INDEXES ALL"},
+		{"analyze table t1 persistent for columns all indexes all", true, "ANALYZE TABLE `t1` PERSISTENT FOR COLUMNS ALL INDEXES ALL"},
+		{"analyze table t1 persistent for", false, ""},
+		{"analyze table t1 persistent for columns (a)", false, ""},
+		{"analyze table t1 persistent for indexes (i)", false, ""},
+		{"analyze table t1 persistent for indexes (i) columns (a)", false, ""},
+		{"analyze local table t,t1 persistent for all", true, "ANALYZE NO_WRITE_TO_BINLOG TABLE `t`,`t1` PERSISTENT FOR ALL"},
+		{"analyze table t1 persistent for all columns (a)", false, ""},
+		{"analyze table t1 persistent", false, ""},
+		{"select decode_histogram(hist_type, histogram) from mysql.column_stats", true, "SELECT DECODE_HISTOGRAM(`hist_type`, `histogram`) FROM `mysql`.`column_stats`"},
+		{"select persistent from persistent", true, "SELECT `persistent` FROM `persistent`"},
+
+		// The TiDB form is disabled by default.
+		{"analyze table t1 index", false, ""},
+		{"analyze table t1 index a", false, ""},
+		{"analyze table t partition a", false, ""},
+		{"analyze table t partition a index b", false, ""},
+		{"alter table t analyze partition a index b", false, ""},
+	}
+	s.RunTest(c, table)
+
+	s.enableTiDBAnalyze = true
+	defer func() { s.enableTiDBAnalyze = false }()
+	table = []testCase{
+		{"analyze table t1", true, "ANALYZE TABLE `t1`"},
 		{"analyze table t1 index", true, "ANALYZE TABLE `t1` INDEX"},
 		{"analyze table t1 index a", true, "ANALYZE TABLE `t1` INDEX `a`"},
 		{"analyze table t1 index a,b", true, "ANALYZE TABLE `t1` INDEX `a`,`b`"},
 		{"analyze table t partition a", true, "ANALYZE TABLE `t` PARTITION `a`"},
+		{"analyze table t partition a,b", true, "ANALYZE TABLE `t` PARTITION `a`,`b`"},
 		{"analyze table t partition a index b", true, "ANALYZE TABLE `t` PARTITION `a` INDEX `b`"},
+		{"alter table t analyze partition a", true, "ALTER TABLE `t` ANALYZE PARTITION `a`"},
+		{"alter table t analyze partition a index b", true, "ALTER TABLE `t` ANALYZE PARTITION `a` INDEX `b`"},
 	}
 	s.RunTest(c, table)
 }
@@ -3156,6 +4084,10 @@ func (checker *nodeTextCleaner) Enter(in ast.Node) (out ast.Node, skipChildren b
 			var tmpCleaner nodeTextCleaner
 			node.Partition.Expr.Accept(&tmpCleaner)
 		}
+	case *ast.SelectStmt:
+		for _, tableHint := range node.TableHints {
+			tableHint.HintName.O = ""
+		}
 	case *ast.DeleteStmt:
 		for _, tableHint := range node.TableHints {
 			tableHint.HintName.O = ""
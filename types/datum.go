@@ -47,6 +47,9 @@ const (
 	KindMaxValue      byte = 16
 	KindRaw           byte = 17
 	KindMysqlJSON     byte = 18
+	KindMysqlInet6    byte = 19
+	KindMysqlUUID     byte = 20
+	KindVectorFloat32 byte = 21
 )
 
 // Datum is a data box holds different kind of data.
@@ -303,6 +306,45 @@ func (d *Datum) SetMysqlJSON(b json.BinaryJSON) {
 	d.b = b.Value
 }
 
+// GetMysqlInet6 gets Inet6 value
+func (d *Datum) GetMysqlInet6() Inet6 {
+	var ret Inet6
+	copy(ret[:], d.b)
+	return ret
+}
+
+// SetMysqlInet6 sets Inet6 value
+func (d *Datum) SetMysqlInet6(b Inet6) {
+	d.k = KindMysqlInet6
+	d.b = b[:]
+}
+
+// GetMysqlUUID gets UUID value
+func (d *Datum) GetMysqlUUID() UUID {
+	var ret UUID
+	copy(ret[:], d.b)
+	return ret
+}
+
+// SetMysqlUUID sets UUID value
+func (d *Datum) SetMysqlUUID(b UUID) {
+	d.k = KindMysqlUUID
+	d.b = b[:]
+}
+
+// GetVectorFloat32 gets VectorFloat32 value
+func (d *Datum) GetVectorFloat32() VectorFloat32 {
+	v, err := ParseVectorFloat32Binary(d.b)
+	terror.Log(errors.Trace(err))
+	return v
+}
+
+// SetVectorFloat32 sets VectorFloat32 value
+func (d *Datum) SetVectorFloat32(v VectorFloat32) {
+	d.k = KindVectorFloat32
+	d.b = v.Bytes()
+}
+
 // GetMysqlTime gets types.Time value
 func (d *Datum) GetMysqlTime() Time {
 	return d.x.(Time)
@@ -361,6 +403,12 @@ func (d *Datum) GetValue() interface{} {
 		return d.GetMysqlSet()
 	case KindMysqlJSON:
 		return d.GetMysqlJSON()
+	case KindMysqlInet6:
+		return d.GetMysqlInet6()
+	case KindMysqlUUID:
+		return d.GetMysqlUUID()
+	case KindVectorFloat32:
+		return d.GetVectorFloat32()
 	case KindMysqlTime:
 		return d.GetMysqlTime()
 	default:
@@ -409,6 +457,12 @@ func (d *Datum) SetValue(val interface{}) {
 		d.SetMysqlSet(x)
 	case json.BinaryJSON:
 		d.SetMysqlJSON(x)
+	case Inet6:
+		d.SetMysqlInet6(x)
+	case UUID:
+		d.SetMysqlUUID(x)
+	case VectorFloat32:
+		d.SetVectorFloat32(x)
 	case Time:
 		d.SetMysqlTime(x)
 	default:
@@ -443,6 +497,12 @@ func (d *Datum) ToString() (string, error) {
 		return d.GetMysqlSet().String(), nil
 	case KindMysqlJSON:
 		return d.GetMysqlJSON().String(), nil
+	case KindMysqlInet6:
+		return d.GetMysqlInet6().String(), nil
+	case KindMysqlUUID:
+		return d.GetMysqlUUID().String(), nil
+	case KindVectorFloat32:
+		return d.GetVectorFloat32().String(), nil
 	case KindBinaryLiteral, KindMysqlBit:
 		return d.GetBinaryLiteral().ToString(), nil
 	default:
@@ -55,6 +55,7 @@ var type2Str = map[byte]string{
 	mysql.TypeEnum:       "enum",
 	mysql.TypeFloat:      "float",
 	mysql.TypeGeometry:   "geometry",
+	mysql.TypeInet6:      "inet6",
 	mysql.TypeInt24:      "mediumint",
 	mysql.TypeJSON:       "json",
 	mysql.TypeLong:       "int",
@@ -69,8 +70,10 @@ var type2Str = map[byte]string{
 	mysql.TypeTimestamp:  "timestamp",
 	mysql.TypeTiny:       "tinyint",
 	mysql.TypeTinyBlob:   "tinytext",
+	mysql.TypeUUID:       "uuid",
 	mysql.TypeVarchar:    "varchar",
 	mysql.TypeVarString:  "var_string",
+	mysql.TypeVector:     "vector",
 	mysql.TypeYear:       "year",
 }
 
@@ -190,6 +193,9 @@ var kind2Str = map[byte]string{
 	KindMaxValue:      "max_value",
 	KindRaw:           "raw",
 	KindMysqlJSON:     "json",
+	KindMysqlInet6:    "inet6",
+	KindMysqlUUID:     "uuid",
+	KindVectorFloat32: "vector",
 }
 
 // KindStr converts kind to a string.
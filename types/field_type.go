@@ -40,6 +40,9 @@ type FieldType struct {
 	Collate string
 	// Elems is the element list for enum and set type.
 	Elems []string
+	// Compressed is true for MariaDB compressed BLOB, TEXT and VARCHAR columns.
+	// zlib is the only compression method, so it isn't recorded.
+	Compressed bool
 }
 
 // NewFieldType returns a FieldType,
@@ -67,6 +70,7 @@ func (ft *FieldType) Equal(other *FieldType) bool {
 		ft.Decimal == other.Decimal &&
 		ft.Charset == other.Charset &&
 		ft.Collate == other.Collate &&
+		ft.Compressed == other.Compressed &&
 		mysql.HasUnsignedFlag(ft.Flag) == mysql.HasUnsignedFlag(other.Flag)
 	if !partialEqual || len(ft.Elems) != len(other.Elems) {
 		return false
@@ -154,7 +158,7 @@ func (ft *FieldType) CompactStr() string {
 		}
 	case mysql.TypeNewDecimal:
 		suffix = fmt.Sprintf("(%d,%d)", displayFlen, displayDecimal)
-	case mysql.TypeBit, mysql.TypeShort, mysql.TypeTiny, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong, mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString:
+	case mysql.TypeBit, mysql.TypeShort, mysql.TypeTiny, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong, mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString, mysql.TypeVector:
 		// Flen is always shown.
 		suffix = fmt.Sprintf("(%d)", displayFlen)
 	case mysql.TypeYear:
@@ -170,6 +174,9 @@ func (ft *FieldType) InfoSchemaStr() string {
 	if mysql.HasUnsignedFlag(ft.Flag) {
 		suffix = " unsigned"
 	}
+	if ft.Compressed {
+		suffix += " /*M!100301 COMPRESSED*/"
+	}
 	return ft.CompactStr() + suffix
 }
 
@@ -195,6 +202,9 @@ func (ft *FieldType) String() string {
 			strs = append(strs, fmt.Sprintf("COLLATE %s", ft.Collate))
 		}
 	}
+	if ft.Compressed {
+		strs = append(strs, "COMPRESSED")
+	}
 
 	return strings.Join(strs, " ")
 }
@@ -225,7 +235,7 @@ func (ft *FieldType) Restore(ctx *format.RestoreCtx) error {
 		if ft.Flen > 0 && ft.Decimal > 0 {
 			ctx.WritePlainf("(%d,%d)", ft.Flen, ft.Decimal)
 		}
-	case mysql.TypeBit, mysql.TypeShort, mysql.TypeTiny, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong, mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeBlob, mysql.TypeLongBlob, mysql.TypeYear:
+	case mysql.TypeBit, mysql.TypeShort, mysql.TypeTiny, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong, mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeBlob, mysql.TypeLongBlob, mysql.TypeYear, mysql.TypeVector:
 		if ft.Flen > 0 {
 			ctx.WritePlainf("(%d)", ft.Flen)
 		}
@@ -250,6 +260,9 @@ func (ft *FieldType) Restore(ctx *format.RestoreCtx) error {
 			ctx.WritePlain(ft.Collate)
 		}
 	}
+	if ft.Compressed {
+		ctx.WriteKeyWord(" COMPRESSED")
+	}
 
 	return nil
 }
@@ -326,6 +339,10 @@ func (ft *FieldType) StorageLength() int {
 	case mysql.TypeNewDecimal:
 		precision, frac := ft.Flen-ft.Decimal, ft.Decimal
 		return precision/digitsPerWord*wordSize + dig2bytes[precision%digitsPerWord] + frac/digitsPerWord*wordSize + dig2bytes[frac%digitsPerWord]
+	case mysql.TypeInet6, mysql.TypeUUID:
+		return 16
+	case mysql.TypeVector:
+		return ft.Flen * 4
 	default:
 		return VarStorageLen
 	}
@@ -558,6 +575,21 @@ func DefaultTypeForValue(value interface{}, tp *FieldType) {
 		tp.Decimal = 0
 		tp.Charset = charset.CharsetBin
 		tp.Collate = charset.CollationBin
+	case Inet6:
+		tp.Tp = mysql.TypeInet6
+		tp.Flen = len(x.String())
+		tp.Decimal = 0
+		SetBinChsClnFlag(tp)
+	case UUID:
+		tp.Tp = mysql.TypeUUID
+		tp.Flen = len(x.String())
+		tp.Decimal = 0
+		SetBinChsClnFlag(tp)
+	case VectorFloat32:
+		tp.Tp = mysql.TypeVector
+		tp.Flen = x.Len()
+		tp.Decimal = 0
+		SetBinChsClnFlag(tp)
 	default:
 		tp.Tp = mysql.TypeUnspecified
 		tp.Flen = UnspecifiedLength
@@ -581,11 +613,26 @@ func DefaultCharsetForType(tp byte) (string, string) {
 // The result field type of the case expression is the merged type of the two when clause.
 // See https://github.com/mysql/mysql-server/blob/5.7/sql/field.cc#L1042
 func MergeFieldType(a byte, b byte) byte {
+	// The MariaDB types are not in fieldTypeMergeRules. They only merge with
+	// themselves and NULL, everything else falls back to a string.
+	if isMariaDBType(a) || isMariaDBType(b) {
+		switch {
+		case a == b || b == mysql.TypeNull:
+			return a
+		case a == mysql.TypeNull:
+			return b
+		}
+		return mysql.TypeVarchar
+	}
 	ia := getFieldTypeIndex(a)
 	ib := getFieldTypeIndex(b)
 	return fieldTypeMergeRules[ia][ib]
 }
 
+func isMariaDBType(tp byte) bool {
+	return tp == mysql.TypeVector || tp == mysql.TypeInet6 || tp == mysql.TypeUUID
+}
+
 func getFieldTypeIndex(tp byte) int {
 	itp := int(tp)
 	if itp < fieldTypeTearFrom {
@@ -162,3 +162,17 @@ func (s *testFieldTypeSuite) TestFieldType(c *C) {
 	ft.Decimal = 2
 	c.Assert(ft.String(), Equals, "year(2)") // Note: Invalid year.
 }
+
+func (s *testFieldTypeSuite) TestEqual(c *C) {
+	ft := NewFieldType(mysql.TypeBlob)
+	other := ft.Clone()
+	c.Assert(ft.Equal(other), IsTrue)
+
+	other.Compressed = true
+	c.Assert(ft.Equal(other), IsFalse)
+	ft.Compressed = true
+	c.Assert(ft.Equal(other), IsTrue)
+
+	other.Flag |= mysql.UnsignedFlag
+	c.Assert(ft.Equal(other), IsFalse)
+}
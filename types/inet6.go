@@ -0,0 +1,65 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"net"
+	"strings"
+)
+
+// Inet6 is for MariaDB INET6 type, an IPv6 address stored as 16 bytes in network byte order.
+// See https://mariadb.com/kb/en/inet6/
+type Inet6 [net.IPv6len]byte
+
+// ParseInet6 parses an IPv6 address, or an IPv4 address which is stored as an IPv4-mapped IPv6 address.
+func ParseInet6(s string) (Inet6, error) {
+	var ret Inet6
+	ip := net.ParseIP(strings.TrimSpace(s))
+	if ip == nil {
+		return ret, ErrTruncatedWrongVal.GenWithStackByArgs("inet6", s)
+	}
+	copy(ret[:], ip.To16())
+	return ret, nil
+}
+
+// ParseInet6Binary creates an Inet6 from its 16-byte binary form.
+func ParseInet6Binary(b []byte) (Inet6, error) {
+	var ret Inet6
+	if len(b) != len(ret) {
+		return ret, ErrTruncatedWrongVal.GenWithStackByArgs("inet6", string(b))
+	}
+	copy(ret[:], b)
+	return ret, nil
+}
+
+// String implements fmt.Stringer interface.
+// IPv4-mapped addresses keep their "::ffff:" prefix, the same as MariaDB.
+func (i Inet6) String() string {
+	ip := net.IP(i[:])
+	if v4 := ip.To4(); v4 != nil {
+		return "::ffff:" + v4.String()
+	}
+	return ip.String()
+}
+
+// Bytes returns the 16-byte binary form of the address.
+func (i Inet6) Bytes() []byte {
+	return i[:]
+}
+
+// Compare returns an integer comparing the Inet6 i to other, in binary order.
+func (i Inet6) Compare(other Inet6) int {
+	return bytes.Compare(i[:], other[:])
+}
@@ -0,0 +1,69 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testInet6Suite{})
+
+type testInet6Suite struct {
+}
+
+func (s *testInet6Suite) TestInet6(c *C) {
+	tbl := []struct {
+		Input    string
+		Expected string
+	}{
+		{"::", "::"},
+		{"::1", "::1"},
+		{"2001:DB8::FF00:42:8329", "2001:db8::ff00:42:8329"},
+		{"192.168.1.1", "::ffff:192.168.1.1"},
+		{"::ffff:10.0.0.1", "::ffff:10.0.0.1"},
+		{"", ""},
+		{"2001:db8::g", ""},
+		{"1.2.3", ""},
+	}
+	for _, t := range tbl {
+		ip, err := ParseInet6(t.Input)
+		if t.Expected == "" {
+			c.Assert(err, NotNil, Commentf("%s", t.Input))
+			continue
+		}
+		c.Assert(err, IsNil)
+		c.Assert(ip.String(), Equals, t.Expected)
+
+		bin, err := ParseInet6Binary(ip.Bytes())
+		c.Assert(err, IsNil)
+		c.Assert(bin, Equals, ip)
+
+		d := NewDatum(ip)
+		c.Assert(d.Kind(), Equals, KindMysqlInet6)
+		str, err := d.ToString()
+		c.Assert(err, IsNil)
+		c.Assert(str, Equals, t.Expected)
+	}
+
+	_, err := ParseInet6Binary([]byte{1, 2, 3})
+	c.Assert(err, NotNil)
+
+	a, err := ParseInet6("::1")
+	c.Assert(err, IsNil)
+	b, err := ParseInet6("::2")
+	c.Assert(err, IsNil)
+	c.Assert(a.Compare(b), Equals, -1)
+	c.Assert(b.Compare(a), Equals, 1)
+	c.Assert(a.Compare(a), Equals, 0)
+}
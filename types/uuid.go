@@ -0,0 +1,91 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+)
+
+// UUIDLen is the length of the binary form of a UUID.
+const UUIDLen = 16
+
+// UUID is for MariaDB UUID type, stored as 16 bytes.
+// See https://mariadb.com/kb/en/uuid-data-type/
+type UUID [UUIDLen]byte
+
+// ParseUUID parses a UUID from its text form. Like MariaDB, both the
+// 8-4-4-4-12 form and 32 plain hexadecimal digits are accepted, and the
+// value may be enclosed in braces.
+func ParseUUID(s string) (UUID, error) {
+	var ret UUID
+	str := s
+	if len(str) > 2 && str[0] == '{' && str[len(str)-1] == '}' {
+		str = str[1 : len(str)-1]
+	}
+	digits := make([]byte, 0, 2*UUIDLen)
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if c == '-' {
+			// A hyphen is only allowed between two bytes.
+			if len(digits) == 0 || len(digits)%2 != 0 || i == len(str)-1 || str[i+1] == '-' {
+				return ret, ErrTruncatedWrongVal.GenWithStackByArgs("uuid", s)
+			}
+			continue
+		}
+		digits = append(digits, c)
+	}
+	if len(digits) != 2*UUIDLen {
+		return ret, ErrTruncatedWrongVal.GenWithStackByArgs("uuid", s)
+	}
+	if _, err := hex.Decode(ret[:], digits); err != nil {
+		return ret, ErrTruncatedWrongVal.GenWithStackByArgs("uuid", s)
+	}
+	return ret, nil
+}
+
+// ParseUUIDBinary creates a UUID from its 16-byte binary form.
+func ParseUUIDBinary(b []byte) (UUID, error) {
+	var ret UUID
+	if len(b) != UUIDLen {
+		return ret, ErrTruncatedWrongVal.GenWithStackByArgs("uuid", string(b))
+	}
+	copy(ret[:], b)
+	return ret, nil
+}
+
+// String implements fmt.Stringer interface.
+func (u UUID) String() string {
+	buf := make([]byte, 36)
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf)
+}
+
+// Bytes returns the 16-byte binary form of the UUID.
+func (u UUID) Bytes() []byte {
+	return u[:]
+}
+
+// Compare returns an integer comparing the UUID u to other, in binary order.
+func (u UUID) Compare(other UUID) int {
+	return bytes.Compare(u[:], other[:])
+}
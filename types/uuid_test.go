@@ -0,0 +1,73 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testUUIDSuite{})
+
+type testUUIDSuite struct {
+}
+
+func (s *testUUIDSuite) TestUUID(c *C) {
+	tbl := []struct {
+		Input    string
+		Expected string
+	}{
+		{"123e4567-e89b-12d3-a456-426655440000", "123e4567-e89b-12d3-a456-426655440000"},
+		{"123E4567E89B12D3A456426655440000", "123e4567-e89b-12d3-a456-426655440000"},
+		{"{123e4567-e89b-12d3-a456-426655440000}", "123e4567-e89b-12d3-a456-426655440000"},
+		{"12-3e-4567e89b12d3a456426655440000", "123e4567-e89b-12d3-a456-426655440000"},
+		{"123e4567-e89b-12d3-a456-42665544000", ""},
+		{"123e4567-e89b-12d3-a456-4266554400000", ""},
+		{"123e4567--e89b-12d3-a456-426655440000", ""},
+		{"-123e4567-e89b-12d3-a456-426655440000", ""},
+		{"1-23e4567-e89b-12d3-a456-426655440000", ""},
+		{"123e4567-e89b-12d3-a456-42665544000g", ""},
+		{"", ""},
+	}
+	for _, t := range tbl {
+		u, err := ParseUUID(t.Input)
+		if t.Expected == "" {
+			c.Assert(err, NotNil, Commentf("%s", t.Input))
+			continue
+		}
+		c.Assert(err, IsNil)
+		c.Assert(u.String(), Equals, t.Expected)
+
+		bin, err := ParseUUIDBinary(u.Bytes())
+		c.Assert(err, IsNil)
+		c.Assert(bin, Equals, u)
+
+		d := NewDatum(u)
+		c.Assert(d.Kind(), Equals, KindMysqlUUID)
+		c.Assert(d.GetMysqlUUID(), Equals, u)
+		str, err := d.ToString()
+		c.Assert(err, IsNil)
+		c.Assert(str, Equals, t.Expected)
+	}
+
+	_, err := ParseUUIDBinary(make([]byte, 15))
+	c.Assert(err, NotNil)
+
+	a, err := ParseUUID("00000000-0000-0000-0000-000000000001")
+	c.Assert(err, IsNil)
+	b, err := ParseUUID("00000000-0000-0000-0000-000000000002")
+	c.Assert(err, IsNil)
+	c.Assert(a.Compare(b), Equals, -1)
+	c.Assert(b.Compare(a), Equals, 1)
+	c.Assert(a.Compare(a), Equals, 0)
+}
@@ -0,0 +1,143 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+)
+
+// MaxVectorDimension is the maximum dimension of a VECTOR column.
+const MaxVectorDimension = 65532
+
+// VectorFloat32 is for MariaDB VECTOR type, a list of float32 values.
+// See https://mariadb.com/kb/en/vector-overview/
+type VectorFloat32 []float32
+
+// ParseVectorFloat32 parses a vector from its text form, such as "[1, 2.5, -3]".
+// It is the same as the VEC_FromText function.
+func ParseVectorFloat32(s string) (VectorFloat32, error) {
+	str := strings.TrimSpace(s)
+	if len(str) < 2 || str[0] != '[' || str[len(str)-1] != ']' {
+		return nil, ErrTruncatedWrongVal.GenWithStackByArgs("vector", s)
+	}
+	str = strings.TrimSpace(str[1 : len(str)-1])
+	if len(str) == 0 {
+		return VectorFloat32{}, nil
+	}
+	items := strings.Split(str, ",")
+	v := make(VectorFloat32, 0, len(items))
+	for _, item := range items {
+		f, err := strconv.ParseFloat(strings.TrimSpace(item), 32)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, ErrTruncatedWrongVal.GenWithStackByArgs("vector", s)
+		}
+		v = append(v, float32(f))
+	}
+	return v, nil
+}
+
+// ParseVectorFloat32Binary creates a vector from its binary form, the
+// little-endian float32 values one after another.
+func ParseVectorFloat32Binary(b []byte) (VectorFloat32, error) {
+	if len(b)%4 != 0 {
+		return nil, ErrTruncatedWrongVal.GenWithStackByArgs("vector", string(b))
+	}
+	v := make(VectorFloat32, len(b)/4)
+	for i := range v {
+		v[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[i*4:]))
+	}
+	return v, nil
+}
+
+// String implements fmt.Stringer interface.
+// It is the same as the VEC_ToText function.
+func (v VectorFloat32) String() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, f := range v {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(float64(f), 'g', -1, 32))
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// Bytes returns the binary form of the vector.
+func (v VectorFloat32) Bytes() []byte {
+	b := make([]byte, len(v)*4)
+	for i, f := range v {
+		binary.LittleEndian.PutUint32(b[i*4:], math.Float32bits(f))
+	}
+	return b
+}
+
+// Len returns the dimension of the vector.
+func (v VectorFloat32) Len() int {
+	return len(v)
+}
+
+// CheckDimension checks whether the vector fits a VECTOR(dim) column.
+func (v VectorFloat32) CheckDimension(dim int) error {
+	if len(v) != dim {
+		return errors.Errorf("vector has %d dimensions, does not fit VECTOR(%d)", len(v), dim)
+	}
+	return nil
+}
+
+func (v VectorFloat32) checkSameDimension(other VectorFloat32) error {
+	if len(v) != len(other) {
+		return errors.Errorf("vectors have different dimensions: %d and %d", len(v), len(other))
+	}
+	return nil
+}
+
+// EuclideanDistance returns the euclidean distance between two vectors.
+// It is the same as the VEC_DISTANCE_EUCLIDEAN function.
+func (v VectorFloat32) EuclideanDistance(other VectorFloat32) (float64, error) {
+	if err := v.checkSameDimension(other); err != nil {
+		return 0, err
+	}
+	var sum float64
+	for i := range v {
+		diff := float64(v[i]) - float64(other[i])
+		sum += diff * diff
+	}
+	return math.Sqrt(sum), nil
+}
+
+// CosineDistance returns the cosine distance between two vectors, which is 1
+// minus the cosine similarity. It is the same as the VEC_DISTANCE_COSINE function.
+func (v VectorFloat32) CosineDistance(other VectorFloat32) (float64, error) {
+	if err := v.checkSameDimension(other); err != nil {
+		return 0, err
+	}
+	var dot, norm1, norm2 float64
+	for i := range v {
+		a, b := float64(v[i]), float64(other[i])
+		dot += a * b
+		norm1 += a * a
+		norm2 += b * b
+	}
+	if norm1 == 0 || norm2 == 0 {
+		return 0, errors.New("cosine distance is undefined for a zero vector")
+	}
+	return 1 - dot/math.Sqrt(norm1*norm2), nil
+}
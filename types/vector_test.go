@@ -0,0 +1,100 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testVectorSuite{})
+
+type testVectorSuite struct {
+}
+
+func (s *testVectorSuite) TestParseVector(c *C) {
+	tbl := []struct {
+		Input    string
+		Expected string
+	}{
+		{"[1,2,3]", "[1,2,3]"},
+		{" [ 1.5 , -2e2 ,0.1 ] ", "[1.5,-200,0.1]"},
+		{"[]", "[]"},
+		{"[1,]", ""},
+		{"[1,a]", ""},
+		{"1,2", ""},
+		{"[1e40]", ""},
+		{"[NaN]", ""},
+		{"", ""},
+	}
+	for _, t := range tbl {
+		v, err := ParseVectorFloat32(t.Input)
+		if t.Expected == "" {
+			c.Assert(err, NotNil, Commentf("%s", t.Input))
+			continue
+		}
+		c.Assert(err, IsNil)
+		c.Assert(v.String(), Equals, t.Expected)
+
+		bin, err := ParseVectorFloat32Binary(v.Bytes())
+		c.Assert(err, IsNil)
+		c.Assert(bin, DeepEquals, v)
+
+		d := NewDatum(v)
+		c.Assert(d.Kind(), Equals, KindVectorFloat32)
+		str, err := d.ToString()
+		c.Assert(err, IsNil)
+		c.Assert(str, Equals, t.Expected)
+	}
+
+	v, err := ParseVectorFloat32Binary([]byte{0, 0, 0x80, 0x3f})
+	c.Assert(err, IsNil)
+	c.Assert(v, DeepEquals, VectorFloat32{1})
+	_, err = ParseVectorFloat32Binary([]byte{0, 0, 0x80})
+	c.Assert(err, NotNil)
+
+	c.Assert(v.CheckDimension(1), IsNil)
+	c.Assert(v.CheckDimension(2), NotNil)
+
+	ft := NewFieldType(0)
+	DefaultTypeForValue(VectorFloat32{1, 2}, ft)
+	c.Assert(ft.CompactStr(), Equals, "vector(2)")
+	c.Assert(ft.StorageLength(), Equals, 8)
+}
+
+func (s *testVectorSuite) TestDistance(c *C) {
+	a := VectorFloat32{1, 2, 3}
+	b := VectorFloat32{4, 6, 3}
+	d, err := a.EuclideanDistance(b)
+	c.Assert(err, IsNil)
+	c.Assert(d, Equals, float64(5))
+
+	d, err = a.CosineDistance(a)
+	c.Assert(err, IsNil)
+	c.Assert(math.Abs(d) < 1e-9, IsTrue)
+	d, err = VectorFloat32{1, 0}.CosineDistance(VectorFloat32{0, 1})
+	c.Assert(err, IsNil)
+	c.Assert(d, Equals, float64(1))
+	d, err = VectorFloat32{1, 0}.CosineDistance(VectorFloat32{-1, 0})
+	c.Assert(err, IsNil)
+	c.Assert(d, Equals, float64(2))
+
+	_, err = a.EuclideanDistance(VectorFloat32{1})
+	c.Assert(err, NotNil)
+	_, err = a.CosineDistance(VectorFloat32{1})
+	c.Assert(err, NotNil)
+	_, err = a.CosineDistance(VectorFloat32{0, 0, 0})
+	c.Assert(err, NotNil)
+}
@@ -18,11 +18,13 @@ import (
 	"math"
 	"regexp"
 	"strconv"
+	"strings"
 	"unicode"
 
 	"github.com/pingcap/errors"
 
 	"github.com/mia0x75/parser/ast"
+	"github.com/mia0x75/parser/model"
 	"github.com/mia0x75/parser/mysql"
 	"github.com/mia0x75/parser/terror"
 )
@@ -35,6 +37,13 @@ const (
 	codeWrongArgument              = terror.ErrCode(mysql.ErrWrongArguments)
 	codeWrongFieldTerminators      = terror.ErrCode(mysql.ErrWrongFieldTerminators)
 	codeTooBigDisplayWidth         = terror.ErrCode(mysql.ErrTooBigDisplaywidth)
+	codeUnknownAlterAlgorithm      = terror.ErrCode(mysql.ErrUnknownAlterAlgorithm)
+	codeUnknownAlterLock           = terror.ErrCode(mysql.ErrUnknownAlterLock)
+	codeUnknownExplainFormat       = terror.ErrCode(mysql.ErrUnknownExplainFormat)
+	codeWrongFieldSpec             = terror.ErrCode(mysql.ErrWrongFieldSpec)
+	codeWrongUsage                 = terror.ErrCode(mysql.ErrWrongUsage)
+	codeBindingMismatch            = terror.ErrCode(mysql.ErrBindingMismatch)
+	codeFeatureVersion             = terror.ErrCode(mysql.ErrNotSupportedYet)
 )
 
 var (
@@ -52,10 +61,24 @@ var (
 	ErrWrongFieldTerminators = terror.ClassParser.New(codeWrongFieldTerminators, mysql.MySQLErrName[mysql.ErrWrongFieldTerminators])
 	// ErrTooBigDisplayWidth returns for data display width exceed limit .
 	ErrTooBigDisplayWidth = terror.ClassParser.New(codeTooBigDisplayWidth, mysql.MySQLErrName[mysql.ErrTooBigDisplaywidth])
+	// ErrUnknownAlterAlgorithm returns for unknown value of the ALGORITHM clause.
+	ErrUnknownAlterAlgorithm = terror.ClassParser.New(codeUnknownAlterAlgorithm, mysql.MySQLErrName[mysql.ErrUnknownAlterAlgorithm])
+	// ErrUnknownAlterLock returns for unknown value of the LOCK clause.
+	ErrUnknownAlterLock = terror.ClassParser.New(codeUnknownAlterLock, mysql.MySQLErrName[mysql.ErrUnknownAlterLock])
+	// ErrUnknownExplainFormat returns for unknown format name of the EXPLAIN statement.
+	ErrUnknownExplainFormat = terror.ClassParser.New(codeUnknownExplainFormat, mysql.MySQLErrName[mysql.ErrUnknownExplainFormat])
+	// ErrWrongFieldSpec returns for a column attribute which doesn't apply to the column type.
+	ErrWrongFieldSpec = terror.ClassParser.New(codeWrongFieldSpec, mysql.MySQLErrName[mysql.ErrWrongFieldSpec])
+	// ErrWrongUsage returns for two clauses which can't be used together.
+	ErrWrongUsage = terror.ClassParser.New(codeWrongUsage, mysql.MySQLErrName[mysql.ErrWrongUsage])
+	// ErrBindingMismatch returns for a binding whose hinted query has a different digest from the original query.
+	ErrBindingMismatch = terror.ClassParser.New(codeBindingMismatch, mysql.MySQLErrName[mysql.ErrBindingMismatch])
+	// ErrFeatureVersion returns for a construct which the target server version does not support.
+	ErrFeatureVersion = terror.ClassParser.New(codeFeatureVersion, "%s requires server version %s, the target is %s, line %d column %d")
 	// SpecFieldPattern special result field pattern
-	SpecFieldPattern = regexp.MustCompile(`(\/\*!(M?[0-9]{5,6})?|\*\/)`)
-	specCodePattern  = regexp.MustCompile(`\/\*!(M?[0-9]{5,6})?([^*]|\*+[^*/])*\*+\/`)
-	specCodeStart    = regexp.MustCompile(`^\/\*!(M?[0-9]{5,6})?[ \t]*`)
+	SpecFieldPattern = regexp.MustCompile(`(\/\*(M!|!)([0-9]{5,6})?|\*\/)`)
+	specCodePattern  = regexp.MustCompile(`\/\*(M!|!)([0-9]{5,6})?([^*]|\*+[^*/])*\*+\/`)
+	specCodeStart    = regexp.MustCompile(`^\/\*(M!|!)([0-9]{5,6})?[ \t]*`)
 	specCodeEnd      = regexp.MustCompile(`[ \t]*\*\/$`)
 )
 
@@ -68,6 +91,13 @@ func init() {
 		codeWrongArgument:              mysql.ErrWrongArguments,
 		codeWrongFieldTerminators:      mysql.ErrWrongFieldTerminators,
 		codeTooBigDisplayWidth:         mysql.ErrTooBigDisplaywidth,
+		codeUnknownAlterAlgorithm:      mysql.ErrUnknownAlterAlgorithm,
+		codeUnknownAlterLock:           mysql.ErrUnknownAlterLock,
+		codeUnknownExplainFormat:       mysql.ErrUnknownExplainFormat,
+		codeWrongFieldSpec:             mysql.ErrWrongFieldSpec,
+		codeWrongUsage:                 mysql.ErrWrongUsage,
+		codeBindingMismatch:            mysql.ErrBindingMismatch,
+		codeFeatureVersion:             mysql.ErrNotSupportedYet,
 	}
 	terror.ErrClassToMySQLCodes[terror.ClassParser] = parserMySQLErrCodes
 }
@@ -86,6 +116,11 @@ type Parser struct {
 	src       string
 	lexer     Scanner
 
+	// tidbAnalyze is set by EnableTiDBAnalyze.
+	tidbAnalyze bool
+	// strictVersion is set by EnableStrictVersion.
+	strictVersion bool
+
 	// the following fields are used by yyParse to reduce allocation.
 	cache  []yySymType
 	yylval yySymType
@@ -152,6 +187,72 @@ func (parser *Parser) lastErrorAsWarn() {
 	parser.lexer.errs = parser.lexer.errs[:len(parser.lexer.errs)-1]
 }
 
+// checkOrReplace checks the OR REPLACE at offset, which is feature f, against the target
+// server version. It reports OR REPLACE given together with IF NOT EXISTS, which contradict
+// each other, and returns false then.
+func (parser *Parser) checkOrReplace(orReplace, ifNotExists bool, f Feature, offset int) bool {
+	if !orReplace {
+		return true
+	}
+	parser.checkFeature(f, offset)
+	if ifNotExists {
+		parser.lexer.AppendError(ErrWrongUsage.GenWithStackByArgs("OR REPLACE", "IF NOT EXISTS"))
+		return false
+	}
+	return true
+}
+
+// checkStoredProgram checks that the stored program named name, which is written in the
+// PL/SQL syntax, is parsed in sql_mode=ORACLE and that the name after its END matches.
+func (parser *Parser) checkStoredProgram(stmt, name, endLabel string) bool {
+	if !parser.lexer.GetSQLMode().HasOracleMode() {
+		parser.lexer.AppendError(parser.lexer.Errorf("%s ... AS is only supported when sql_mode=ORACLE is set", stmt))
+		return false
+	}
+	return parser.checkEndLabel(name, endLabel)
+}
+
+// checkEndLabel checks that the optional name after the END of a stored program is its name.
+func (parser *Parser) checkEndLabel(name, endLabel string) bool {
+	if endLabel != "" && !strings.EqualFold(name, endLabel) {
+		parser.lexer.AppendError(parser.lexer.Errorf("END identifier %s does not match %s", endLabel, name))
+		return false
+	}
+	return true
+}
+
+// checkFeature reports the use of f at offset if the target server version does not support it.
+func (parser *Parser) checkFeature(f Feature, offset int) {
+	version := parser.lexer.serverVersion
+	if version == 0 || version >= f.Version() {
+		return
+	}
+	line := strings.Count(parser.src[:offset], "\n") + 1
+	col := offset - strings.LastIndexByte(parser.src[:offset], '\n') - 1
+	err := ErrFeatureVersion.GenWithStackByArgs(f, formatServerVersion(f.Version()), formatServerVersion(version), line, col)
+	if parser.strictVersion {
+		parser.lexer.AppendError(err)
+	} else {
+		parser.lexer.warns = append(parser.lexer.warns, err)
+	}
+}
+
+// setExecutableComment records on stmt the executable comment it is wholly written in, if any.
+// start is the offset of the first token of stmt, and the lookahead token follows its last one.
+func (parser *Parser) setExecutableComment(stmt ast.StmtNode, start int) {
+	node, ok := stmt.(ast.ExecutableCommentNode)
+	comment := parser.lexer.lastExecutableComment
+	if !ok || comment == nil {
+		return
+	}
+	begin, end := parser.lexer.lastExecutableCommentPos[0], parser.lexer.lastExecutableCommentPos[1]
+	next := parser.yylval.offset
+	if start < begin || start >= end || next < end || strings.TrimSpace(parser.src[end:next]) != "" {
+		return
+	}
+	node.SetExecutableComment(comment)
+}
+
 // ParseOneStmt parses a query and returns an ast.StmtNode.
 // The query must have one statement, otherwise ErrSyntax is returned.
 func (parser *Parser) ParseOneStmt(sql, charset, collation string) (ast.StmtNode, error) {
@@ -171,11 +272,29 @@ func (parser *Parser) SetSQLMode(mode mysql.SQLMode) {
 	parser.lexer.SetSQLMode(mode)
 }
 
+// SetServerVersion sets the MYSQL_VERSION_ID of the target server, such as 100611 for 10.6.11.
+// The content of a version gated comment is skipped if the server would not execute it.
+func (parser *Parser) SetServerVersion(version int) {
+	parser.lexer.SetServerVersion(version)
+}
+
+// EnableStrictVersion controls whether a construct which the server version set by SetServerVersion
+// does not support is an error. It is a warning otherwise.
+func (parser *Parser) EnableStrictVersion(val bool) {
+	parser.strictVersion = val
+}
+
 // EnableWindowFunc controls whether the parser to parse syntax related with window function.
 func (parser *Parser) EnableWindowFunc(val bool) {
 	parser.lexer.EnableWindowFunc(val)
 }
 
+// EnableTiDBAnalyze controls whether the parser accepts the TiDB form of ANALYZE TABLE,
+// which analyzes the given partitions or indexes. MariaDB uses the PERSISTENT FOR clause instead.
+func (parser *Parser) EnableTiDBAnalyze(val bool) {
+	parser.tidbAnalyze = val
+}
+
 // ParseErrorWith returns "You have a syntax error near..." error message compatible with mysql.
 func ParseErrorWith(errstr string, lineno int) error {
 	if len(errstr) > mysql.ErrTextLength {
@@ -188,6 +307,9 @@ func ParseErrorWith(errstr string, lineno int) error {
 // field text was set from its offset to the end of the src string, update
 // the last field text.
 func (parser *Parser) setLastSelectFieldText(st *ast.SelectStmt, lastEnd int) {
+	if st.Kind != ast.SelectStmtKindSelect {
+		return
+	}
 	lastField := st.Fields.Fields[len(st.Fields.Fields)-1]
 	if lastField.Offset+len(lastField.Text()) >= len(parser.src)-1 {
 		lastField.SetText(parser.src[lastField.Offset:lastEnd])
@@ -276,6 +398,15 @@ func toBit(l yyLexer, lval *yySymType, str string) int {
 	return bitLit
 }
 
+func isExplainFormat(name string) bool {
+	for _, format := range ast.ExplainFormats {
+		if strings.EqualFold(name, format) {
+			return true
+		}
+	}
+	return false
+}
+
 func getUint64FromNUM(num interface{}) uint64 {
 	switch v := num.(type) {
 	case int64:
@@ -285,3 +416,74 @@ func getUint64FromNUM(num interface{}) uint64 {
 	}
 	return 0
 }
+
+// ignoreLines is the IGNORE n LINES or IGNORE n ROWS clause of LOAD DATA and LOAD XML.
+type ignoreLines struct {
+	num  uint64
+	rows bool
+}
+
+// setLoadDataStmt sets the parts shared by LOAD DATA and LOAD XML.
+func setLoadDataStmt(x *ast.LoadDataStmt, isLocal bool, ignore, assignments interface{}) {
+	if isLocal {
+		x.IsLocal = true
+		// See https://dev.mysql.com/doc/refman/5.7/en/load-data.html#load-data-duplicate-key-handling
+		// If you do not specify IGNORE or REPLACE modifier , then we set default behavior to IGNORE when LOCAL modifier is specified
+		if x.OnDuplicate == ast.OnDuplicateKeyHandlingError {
+			x.OnDuplicate = ast.OnDuplicateKeyHandlingIgnore
+		}
+	}
+	if ignore != nil {
+		x.IgnoreLines = ignore.(*ignoreLines).num
+		x.IgnoreRows = ignore.(*ignoreLines).rows
+	}
+	if assignments != nil {
+		x.ColumnAssignments = assignments.([]*ast.Assignment)
+	}
+	columns := []*ast.ColumnName{}
+	for _, v := range x.ColumnsAndUserVars {
+		if v.ColumnName != nil {
+			columns = append(columns, v.ColumnName)
+		}
+	}
+	x.Columns = columns
+}
+
+// setVectorIndexOption takes the M and DISTANCE options of a vector index out of
+// the engine defined attributes, see https://mariadb.com/kb/en/create-table-with-vectors/
+func setVectorIndexOption(opt *ast.IndexOption) error {
+	attrs := opt.EngineAttributes[:0]
+	for _, attr := range opt.EngineAttributes {
+		switch strings.ToLower(attr.Name) {
+		case "m":
+			m, err := strconv.ParseUint(attr.Value, 10, 64)
+			if attr.Tp != model.EngineAttributeValueNumber || err != nil || m < 3 || m > 200 {
+				return ErrWrongArguments.GenWithStackByArgs("M")
+			}
+			if opt.VectorInfo == nil {
+				opt.VectorInfo = &model.VectorIndexInfo{}
+			}
+			opt.VectorInfo.M = m
+		case "distance":
+			var distance model.VectorDistance
+			switch {
+			case attr.Tp != model.EngineAttributeValueIdent:
+			case strings.EqualFold(attr.Value, "euclidean"):
+				distance = model.VectorDistanceEuclidean
+			case strings.EqualFold(attr.Value, "cosine"):
+				distance = model.VectorDistanceCosine
+			}
+			if distance == model.VectorDistanceDefault {
+				return ErrWrongArguments.GenWithStackByArgs("DISTANCE")
+			}
+			if opt.VectorInfo == nil {
+				opt.VectorInfo = &model.VectorIndexInfo{}
+			}
+			opt.VectorInfo.Distance = distance
+		default:
+			attrs = append(attrs, attr)
+		}
+	}
+	opt.EngineAttributes = attrs
+	return nil
+}